@@ -0,0 +1,4 @@
+data "stackit_argus_instances" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name_prefix = "prod-"
+}
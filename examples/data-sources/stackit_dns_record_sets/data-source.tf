@@ -0,0 +1,7 @@
+data "stackit_dns_record_sets" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  zone_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "www.example.com."
+  type       = "A"
+  active     = true
+}
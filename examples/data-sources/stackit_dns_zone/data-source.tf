@@ -2,3 +2,9 @@ data "stackit_dns_zone" "example" {
   project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   zone_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
 }
+
+# Look up a zone by its DNS name instead of its ID
+data "stackit_dns_zone" "by_dns_name" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  dns_name   = "example.runs.onstackit.cloud"
+}
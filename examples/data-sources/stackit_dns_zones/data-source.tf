@@ -0,0 +1,4 @@
+data "stackit_dns_zones" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  active     = true
+}
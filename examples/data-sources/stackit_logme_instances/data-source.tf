@@ -0,0 +1,4 @@
+data "stackit_logme_instances" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "example-name"
+}
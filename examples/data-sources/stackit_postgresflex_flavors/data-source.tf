@@ -0,0 +1,4 @@
+data "stackit_postgresflex_flavors" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cpu        = 2
+}
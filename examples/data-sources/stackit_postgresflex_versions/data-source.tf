@@ -0,0 +1,28 @@
+data "stackit_postgresflex_versions" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Fail early if the pinned version is no longer supported
+resource "stackit_postgresflex_instance" "example" {
+  project_id      = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name            = "example-instance"
+  acl             = ["XXX.XXX.XXX.X/XX", "XX.XXX.XX.X/XX"]
+  backup_schedule = "00 00 * * *"
+  flavor = {
+    cpu = 2
+    ram = 4
+  }
+  replicas = 3
+  storage = {
+    class = "class"
+    size  = 5
+  }
+  version = "14"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.stackit_postgresflex_versions.example.versions, "14")
+      error_message = "PostgreSQL 14 is no longer supported by PostgresFlex, latest is ${data.stackit_postgresflex_versions.example.latest}."
+    }
+  }
+}
@@ -0,0 +1,4 @@
+data "stackit_postgresql_instances" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  plan_name  = "stackit-postgresql-single-small"
+}
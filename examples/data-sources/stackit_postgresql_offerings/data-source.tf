@@ -0,0 +1,4 @@
+data "stackit_postgresql_offerings" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  version    = "13"
+}
@@ -0,0 +1,13 @@
+data "stackit_provider_info" "example" {}
+
+# Fail early if the provider in use doesn't support a resource type the module needs
+resource "stackit_ske_project" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.stackit_provider_info.example.resources, "stackit_ske_cluster")
+      error_message = "Provider version ${data.stackit_provider_info.example.version} doesn't support stackit_ske_cluster."
+    }
+  }
+}
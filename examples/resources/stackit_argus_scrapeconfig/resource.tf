@@ -14,4 +14,11 @@ resource "stackit_argus_scrapeconfig" "example" {
       }
     }
   ]
+  metrics_relabel_configs = [
+    {
+      source_labels = ["__name__"]
+      action        = "drop"
+      regex         = "go_gc_.*"
+    }
+  ]
 }
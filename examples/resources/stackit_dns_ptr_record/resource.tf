@@ -0,0 +1,14 @@
+resource "stackit_dns_zone" "reverse" {
+  project_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name          = "Example reverse zone"
+  dns_name      = "2.0.192.in-addr.arpa"
+  contact_email = "aa@bb.ccc"
+  type          = "primary"
+}
+
+resource "stackit_dns_ptr_record" "example" {
+  project_id = stackit_dns_zone.reverse.project_id
+  zone_id    = stackit_dns_zone.reverse.zone_id
+  ip_address = "192.0.2.10"
+  target     = "www.example.com."
+}
@@ -5,4 +5,27 @@ resource "stackit_dns_record_set" "example" {
   type       = "A"
   comment    = "Example comment"
   records    = ["1.2.3.4"]
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+
+resource "stackit_dns_record_set" "example_mx" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  zone_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "example-zone.com"
+  type       = "MX"
+  mx_records = [
+    {
+      priority = 10
+      exchange = "mail.example-zone.com."
+    },
+    {
+      priority = 20
+      exchange = "backup-mail.example-zone.com."
+    },
+  ]
 }
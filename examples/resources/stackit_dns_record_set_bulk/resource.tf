@@ -0,0 +1,17 @@
+resource "stackit_dns_record_set_bulk" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  zone_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  record_sets = [
+    {
+      name    = "www"
+      type    = "A"
+      ttl     = 3600
+      records = ["1.2.3.4", "5.6.7.8"]
+    },
+    {
+      name    = "mail"
+      type    = "MX"
+      records = ["10 mx.example.com."]
+    },
+  ]
+}
@@ -1,10 +1,26 @@
 resource "stackit_dns_zone" "example" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name           = "Example zone"
+  dns_name       = "www.example-zone.com"
+  contact_email  = "aa@bb.ccc"
+  type           = "primary"
+  acl            = "192.168.0.0/24"
+  description    = "Example description"
+  default_ttl    = 1230
+  refresh_time   = 3600
+  retry_time     = 600
+  expire_time    = 1209600
+  negative_cache = 60
+}
+
+# Zone with the common bootstrap records
+resource "stackit_dns_zone" "example_with_default_records" {
   project_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
-  name          = "Example zone"
-  dns_name      = "www.example-zone.com"
+  name          = "Example zone with default records"
+  dns_name      = "example-zone.com"
   contact_email = "aa@bb.ccc"
-  type          = "primary"
-  acl           = "192.168.0.0/24"
-  description   = "Example description"
-  default_ttl   = 1230
+  create_default_records = {
+    apex_a    = ["1.2.3.4"]
+    www_cname = true
+  }
 }
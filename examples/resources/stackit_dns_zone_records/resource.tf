@@ -0,0 +1,5 @@
+resource "stackit_dns_zone_records" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  zone_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  zone_file  = file("${path.module}/example.com.zone")
+}
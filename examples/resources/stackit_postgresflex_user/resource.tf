@@ -3,4 +3,7 @@ resource "stackit_postgresflex_user" "example" {
   instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   username    = "username"
   roles       = ["role"]
+  rotate_when_changed = {
+    password_reset = "2023-10-01"
+  }
 }
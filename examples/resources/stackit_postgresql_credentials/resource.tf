@@ -1,4 +1,7 @@
 resource "stackit_postgresql_credentials" "example" {
   project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  rotate_when_changed = {
+    rotation = "2023-10-01"
+  }
 }
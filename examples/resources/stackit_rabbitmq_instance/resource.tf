@@ -5,5 +5,6 @@ resource "stackit_rabbitmq_instance" "example" {
   plan_name  = "example-plan-name"
   parameters = {
     sgw_acl = "x.x.x.x/x,y.y.y.y/y"
+    plugins = ["rabbitmq_shovel", "rabbitmq_federation"]
   }
 }
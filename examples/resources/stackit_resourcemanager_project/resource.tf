@@ -6,4 +6,10 @@ resource "stackit_resourcemanager_project" "example" {
     "Label 1" = "foo"
   }
   owner_email = "aa@bb.ccc"
+  members = [
+    {
+      subject = "cc@dd.eee"
+      role    = "project.member"
+    }
+  ]
 }
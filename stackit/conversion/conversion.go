@@ -25,6 +25,15 @@ func ToTypeInt64(i *int32) types.Int64 {
 	return types.Int64Value(int64(*i))
 }
 
+// ToOptionalString maps a value of an optional feature, e.g. an endpoint that isn't offered in every region.
+// Missing and empty values are both mapped to null, so that unavailable features are consistently null in the state.
+func ToOptionalString(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
+
 func ToString(ctx context.Context, v attr.Value) (string, error) {
 	if t := v.Type(ctx); t != types.StringType {
 		return "", fmt.Errorf("type mismatch. expected 'types.StringType' but got '%s'", t.String())
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ServiceRoundTripper returns the round tripper to be used by the API client of the given service, e.g. "dns".
+// If a concurrency limit is configured for the service, the returned round tripper enforces it.
+func (p *ProviderData) ServiceRoundTripper(service string) http.RoundTripper {
+	if rt, ok := p.ServiceRoundTrippers[service]; ok {
+		return rt
+	}
+	return p.RoundTripper
+}
+
+// concurrencyLimitedRoundTripper bounds the number of simultaneous in-flight requests sent through next.
+// A request is in flight until its response body is closed, so reading the responses is limited as well.
+type concurrencyLimitedRoundTripper struct {
+	next http.RoundTripper
+	sem  chan struct{}
+}
+
+// NewConcurrencyLimitedRoundTripper returns a round tripper that allows at most limit requests through next at the same time.
+// Further requests wait until a slot is free or their context is done.
+// The slot of a request is freed when its response body is closed, or when no response is returned.
+// The same round tripper has to be shared by all API clients the limit should apply to.
+func NewConcurrencyLimitedRoundTripper(next http.RoundTripper, limit int) http.RoundTripper {
+	if limit < 1 {
+		limit = 1
+	}
+	return &concurrencyLimitedRoundTripper{
+		next: next,
+		sem:  make(chan struct{}, limit),
+	}
+}
+
+func (rt *concurrencyLimitedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case rt.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil || resp == nil || resp.Body == nil {
+		<-rt.sem
+		return resp, err
+	}
+	resp.Body = &releasingBody{
+		ReadCloser: resp.Body,
+		release:    func() { <-rt.sem },
+	}
+	return resp, nil
+}
+
+// releasingBody calls release when the response body is closed for the first time.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,143 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type blockingRoundTripper struct {
+	release  chan struct{}
+	inFlight int32
+	maxSeen  int32
+}
+
+func (rt *blockingRoundTripper) RoundTrip(_ *http.Request) (*http.Response, error) {
+	n := atomic.AddInt32(&rt.inFlight, 1)
+	for {
+		seen := atomic.LoadInt32(&rt.maxSeen)
+		if n <= seen || atomic.CompareAndSwapInt32(&rt.maxSeen, seen, n) {
+			break
+		}
+	}
+	<-rt.release
+	atomic.AddInt32(&rt.inFlight, -1)
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestConcurrencyLimitedRoundTripper(t *testing.T) {
+	inner := &blockingRoundTripper{release: make(chan struct{})}
+	rt := NewConcurrencyLimitedRoundTripper(inner, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost", http.NoBody)
+			_, err := rt.RoundTrip(req) //nolint:bodyclose // no body in test response
+			if err != nil {
+				t.Errorf("Should not have failed: %v", err)
+			}
+		}()
+	}
+	// Give the requests time to pile up before releasing them one by one
+	time.Sleep(50 * time.Millisecond)
+	for i := 0; i < 5; i++ {
+		inner.release <- struct{}{}
+	}
+	wg.Wait()
+
+	if maxSeen := atomic.LoadInt32(&inner.maxSeen); maxSeen != 2 {
+		t.Fatalf("Expected at most 2 requests in flight, got %d", maxSeen)
+	}
+}
+
+func TestConcurrencyLimitedRoundTripperContextDone(t *testing.T) {
+	inner := &blockingRoundTripper{release: make(chan struct{})}
+	rt := NewConcurrencyLimitedRoundTripper(inner, 1)
+
+	// Occupy the only slot
+	go func() {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost", http.NoBody)
+		_, _ = rt.RoundTrip(req) //nolint:bodyclose // no body in test response
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", http.NoBody)
+	_, err := rt.RoundTrip(req) //nolint:bodyclose // no response on error
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context deadline error, got %v", err)
+	}
+	inner.release <- struct{}{}
+}
+
+type bodyRoundTripper struct{}
+
+func (rt *bodyRoundTripper) RoundTrip(_ *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func TestConcurrencyLimitedRoundTripperBody(t *testing.T) {
+	rt := NewConcurrencyLimitedRoundTripper(&bodyRoundTripper{}, 1)
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost", http.NoBody)
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+
+	// The slot is held until the body of the first response is closed
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", http.NoBody)
+	_, err = rt.RoundTrip(req) //nolint:bodyclose // no response on error
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context deadline error, got %v", err)
+	}
+
+	// Closing the body twice frees the slot only once
+	if err := resp.Body.Close(); err != nil {
+		t.Fatalf("Closing the body: %v", err)
+	}
+	if err := resp.Body.Close(); err != nil {
+		t.Fatalf("Closing the body: %v", err)
+	}
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost", http.NoBody)
+	resp, err = rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if len(rt.(*concurrencyLimitedRoundTripper).sem) != 1 {
+		t.Fatalf("Expected 1 slot in use")
+	}
+	if err := resp.Body.Close(); err != nil {
+		t.Fatalf("Closing the body: %v", err)
+	}
+	if len(rt.(*concurrencyLimitedRoundTripper).sem) != 0 {
+		t.Fatalf("Expected no slot in use")
+	}
+}
+
+func TestServiceRoundTripper(t *testing.T) {
+	defaultRoundTripper := &blockingRoundTripper{}
+	dnsRoundTripper := NewConcurrencyLimitedRoundTripper(defaultRoundTripper, 1)
+	providerData := ProviderData{
+		RoundTripper:         defaultRoundTripper,
+		ServiceRoundTrippers: map[string]http.RoundTripper{"dns": dnsRoundTripper},
+	}
+	if providerData.ServiceRoundTripper("dns") != dnsRoundTripper {
+		t.Fatalf("Expected the limited round tripper for dns")
+	}
+	if providerData.ServiceRoundTripper("ske") != defaultRoundTripper {
+		t.Fatalf("Expected the default round tripper for ske")
+	}
+}
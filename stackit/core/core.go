@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -15,6 +16,7 @@ const Separator = ","
 
 type ProviderData struct {
 	RoundTripper                  http.RoundTripper
+	ServiceRoundTrippers          map[string]http.RoundTripper
 	ServiceAccountEmail           string
 	Region                        string
 	DnsCustomEndpoint             string
@@ -28,6 +30,13 @@ type ProviderData struct {
 	ArgusCustomEndpoint           string
 	SKECustomEndpoint             string
 	ResourceManagerCustomEndpoint string
+	// Strict turns the warnings emitted by the provider into errors, see LogAndAddWarning
+	Strict bool
+	// DefaultTimeouts are used by the wait handlers of resources without a timeouts block of their own
+	DefaultTimeouts Timeouts
+	// SKEVersionExpirationWarningDays is the number of days before the expiration of a Kubernetes version
+	// from which SKE clusters using it are warned about at plan time. Zero disables the warning
+	SKEVersionExpirationWarningDays int64
 }
 
 // DiagsToError Converts TF diagnostics' errors into an error with a human-readable description.
@@ -54,3 +63,48 @@ func LogAndAddError(ctx context.Context, diags *diag.Diagnostics, summary, detai
 	tflog.Error(ctx, summary)
 	(*diags).AddError(summary, detail)
 }
+
+// LogAndAddWarning Logs the warning and adds it to the diags.
+// If strict is set, i.e. the provider runs in strict mode, it is added as an error instead, failing the operation.
+func LogAndAddWarning(ctx context.Context, diags *diag.Diagnostics, strict bool, summary, detail string) {
+	if strict {
+		strictDetail := "This warning is treated as an error, as the provider is configured with strict = true."
+		if detail != "" {
+			strictDetail = detail + "\n\n" + strictDetail
+		}
+		tflog.Error(ctx, summary)
+		(*diags).AddError(summary, strictDetail)
+		return
+	}
+	tflog.Warn(ctx, summary)
+	(*diags).AddWarning(summary, detail)
+}
+
+// ServiceEnablementError checks if err is an API error with status code 403 or 404 returned by a project-level request.
+// Such a request fails this way if the service is not enabled in the project, so a human-readable error explaining how to
+// enable it is returned instead. Any other error is returned unchanged.
+// Only use it for project-scoped list and create requests: for requests on a specific object, e.g. reading or updating an instance,
+// a 404 usually means that the object doesn't exist.
+// howToEnable describes how the service is enabled, e.g. "create a stackit_ske_project resource first"
+func ServiceEnablementError(err error, serviceName, projectId, howToEnable string) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr interface{ StatusCode() int }
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	if apiErr.StatusCode() != http.StatusForbidden && apiErr.StatusCode() != http.StatusNotFound {
+		return err
+	}
+	return fmt.Errorf("service %s is not enabled in project %s or you are not allowed to use it — %s: %w", serviceName, projectId, howToEnable, err)
+}
+
+// IsNotFound checks if err is an API error with status code 404.
+func IsNotFound(err error) bool {
+	var apiErr interface{ StatusCode() int }
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode() == http.StatusNotFound
+}
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type apiError struct {
+	statusCode int
+}
+
+func (e apiError) Error() string {
+	return fmt.Sprintf("status code %d", e.statusCode)
+}
+
+func (e apiError) StatusCode() int {
+	return e.statusCode
+}
+
+func TestServiceEnablementError(t *testing.T) {
+	tests := []struct {
+		description      string
+		input            error
+		howToEnable      string
+		expectedContains string
+		isWrapped        bool
+	}{
+		{
+			"nil",
+			nil,
+			"",
+			"",
+			false,
+		},
+		{
+			"forbidden",
+			&apiError{statusCode: http.StatusForbidden},
+			"activate DNS in the portal",
+			"service DNS is not enabled in project pid or you are not allowed to use it — activate DNS in the portal",
+			true,
+		},
+		{
+			"not_found",
+			&apiError{statusCode: http.StatusNotFound},
+			"",
+			"service DNS is not enabled in project pid",
+			true,
+		},
+		{
+			"custom_hint",
+			apiError{statusCode: http.StatusNotFound},
+			"create a stackit_ske_project resource first",
+			"create a stackit_ske_project resource first",
+			true,
+		},
+		{
+			"wrapped_api_error",
+			fmt.Errorf("calling API: %w", &apiError{statusCode: http.StatusForbidden}),
+			"",
+			"service DNS is not enabled in project pid",
+			true,
+		},
+		{
+			"other_status_code",
+			&apiError{statusCode: http.StatusBadRequest},
+			"",
+			"status code 400",
+			false,
+		},
+		{
+			"not_an_api_error",
+			fmt.Errorf("some error"),
+			"",
+			"some error",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := ServiceEnablementError(tt.input, "DNS", "pid", tt.howToEnable)
+			if tt.input == nil {
+				if err != nil {
+					t.Fatalf("Should have returned nil, got %v", err)
+				}
+				return
+			}
+			if !strings.Contains(err.Error(), tt.expectedContains) {
+				t.Fatalf("Error %q does not contain %q", err.Error(), tt.expectedContains)
+			}
+			if !errors.Is(err, tt.input) {
+				t.Fatalf("Error %q does not wrap the original error", err.Error())
+			}
+			if tt.isWrapped == (err == tt.input) {
+				t.Fatalf("Unexpected wrapping of error %q", err.Error())
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		description string
+		input       error
+		expected    bool
+	}{
+		{"nil", nil, false},
+		{"not_found", &apiError{statusCode: http.StatusNotFound}, true},
+		{"not_found_value", apiError{statusCode: http.StatusNotFound}, true},
+		{"wrapped_not_found", fmt.Errorf("calling API: %w", &apiError{statusCode: http.StatusNotFound}), true},
+		{"forbidden", &apiError{statusCode: http.StatusForbidden}, false},
+		{"not_an_api_error", fmt.Errorf("not found"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := IsNotFound(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestLogAndAddWarning(t *testing.T) {
+	tests := []struct {
+		description string
+		strict      bool
+		detail      string
+		expected    diag.Diagnostics
+	}{
+		{
+			"warning",
+			false,
+			"detail",
+			diag.Diagnostics{diag.NewWarningDiagnostic("summary", "detail")},
+		},
+		{
+			"strict",
+			true,
+			"detail",
+			diag.Diagnostics{diag.NewErrorDiagnostic("summary", "detail\n\nThis warning is treated as an error, as the provider is configured with strict = true.")},
+		},
+		{
+			"strict_without_detail",
+			true,
+			"",
+			diag.Diagnostics{diag.NewErrorDiagnostic("summary", "This warning is treated as an error, as the provider is configured with strict = true.")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var diags diag.Diagnostics
+			LogAndAddWarning(context.Background(), &diags, tt.strict, "summary", tt.detail)
+			if !diags.Equal(tt.expected) {
+				t.Fatalf("Expected %v, got %v", tt.expected, diags)
+			}
+		})
+	}
+}
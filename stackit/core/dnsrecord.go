@@ -0,0 +1,56 @@
+package core
+
+import (
+	"strings"
+)
+
+// CanonicalDNSRecord returns the canonical form of a record of the given record set type, used to compare records semantically.
+// The hostnames of CNAME, NS, PTR, MX and SRV records are compared case-insensitively and regardless of the trailing dot.
+// Records of other types are returned as they are, the content of TXT records is case-sensitive.
+func CanonicalDNSRecord(recordType, record string) string {
+	switch strings.ToUpper(recordType) {
+	case "TXT":
+		return CanonicalTXTRecord(record)
+	case "CNAME", "NS", "PTR", "MX", "SRV":
+		// The hostname is the last field, e.g. `10 mail.example.com.`
+		fields := strings.Fields(strings.ToLower(record))
+		if len(fields) > 0 && fields[len(fields)-1] != "." {
+			fields[len(fields)-1] = strings.TrimSuffix(fields[len(fields)-1], ".")
+		}
+		return strings.Join(fields, " ")
+	default:
+		return record
+	}
+}
+
+// CanonicalTXTRecord returns the text of a TXT record, regardless of how it is split into quoted character strings.
+// E.g. `"v=spf1 " "-all"`, `"v=spf1 -all"` and `v=spf1 -all` have the same canonical form.
+// Whitespace between the character strings and around the record is ignored, escaped quotes and backslashes are unescaped.
+func CanonicalTXTRecord(record string) string {
+	record = strings.TrimSpace(record)
+	if !strings.HasPrefix(record, "\"") {
+		return record
+	}
+	var text strings.Builder
+	quoted := false
+	escaped := false
+	for _, c := range record {
+		switch {
+		case escaped:
+			text.WriteRune(c)
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+			text.WriteRune(c)
+		case c == ' ' || c == '\t':
+			// Separator between character strings
+		default:
+			// Unquoted text isn't expected after a quoted character string, keep it as is
+			text.WriteRune(c)
+		}
+	}
+	return text.String()
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestCanonicalTXTRecord(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    string
+	}{
+		{"unquoted", "v=spf1 -all", "v=spf1 -all"},
+		{"single_string", "\"v=spf1 -all\"", "v=spf1 -all"},
+		{"multiple_strings", "\"v=spf1 \" \"-all\"", "v=spf1 -all"},
+		{"no_separator", "\"v=spf1 \"\"-all\"", "v=spf1 -all"},
+		{"surrounding_whitespace", "  \"v=spf1\"\t\"  -all\" ", "v=spf1  -all"},
+		{"escaped_characters", "\"say \\\"hi\\\" \\\\ bye\"", "say \"hi\" \\ bye"},
+		{"case_is_kept", "\"ABC\" \"def\"", "ABCdef"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := CanonicalTXTRecord(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestCanonicalDNSRecord(t *testing.T) {
+	tests := []struct {
+		description string
+		recordType  string
+		input       string
+		expected    string
+	}{
+		{"txt_rechunked", "TXT", "\"v=spf1 \" \"-all\"", "v=spf1 -all"},
+		{"txt_case_is_kept", "txt", "\"Token=ABC\"", "Token=ABC"},
+		{"cname", "CNAME", "Target.Example.com.", "target.example.com"},
+		{"ns", "NS", "NS1.example.com", "ns1.example.com"},
+		{"ptr", "PTR", "Host.example.com.", "host.example.com"},
+		{"mx", "MX", "10  Mail.example.com.", "10 mail.example.com"},
+		{"srv", "SRV", "10 5 443 Target.example.com.", "10 5 443 target.example.com"},
+		{"root_target", "MX", "0 .", "0 ."},
+		{"other_type_as_is", "CAA", "0 issue \"Example.org\"", "0 issue \"Example.org\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := CanonicalDNSRecord(tt.recordType, tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
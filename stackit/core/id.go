@@ -0,0 +1,67 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CanonicalizeID returns the canonical form of an ID passed from outside the provider, so it can be used with the API.
+// Surrounding whitespace is removed, URN-style IDs are reduced to their last segment
+// (e.g. "urn:stackit:project:<uuid>") and UUIDs are returned in lower case with hyphens.
+// IDs that are not UUIDs are only trimmed.
+func CanonicalizeID(id string) string {
+	id = strings.TrimSpace(id)
+	if strings.HasPrefix(strings.ToLower(id), "urn:") {
+		id = id[strings.LastIndex(id, ":")+1:]
+	}
+	if parsed, err := uuid.Parse(id); err == nil {
+		return parsed.String()
+	}
+	return id
+}
+
+// CanonicalizeIDValue applies CanonicalizeID to a known string value. Null and unknown values are returned unchanged.
+func CanonicalizeIDValue(v types.String) types.String {
+	if v.IsNull() || v.IsUnknown() {
+		return v
+	}
+	return types.StringValue(CanonicalizeID(v.ValueString()))
+}
+
+// idEscape is the character that escapes a Separator or itself inside a part of a TF-internal ID.
+const idEscape = `\`
+
+// JoinID builds a TF-internal ID from its parts, separated by Separator.
+// Separators and escape characters in the parts are escaped with a backslash, so the parts can be
+// recovered with SplitID even if they contain commas. Parts without them are joined unchanged,
+// which keeps IDs of existing resources stable.
+func JoinID(parts ...string) string {
+	escaped := make([]string, len(parts))
+	for i, part := range parts {
+		part = strings.ReplaceAll(part, idEscape, idEscape+idEscape)
+		escaped[i] = strings.ReplaceAll(part, Separator, idEscape+Separator)
+	}
+	return strings.Join(escaped, Separator)
+}
+
+// SplitID splits a TF-internal ID or an import identifier into its parts, reversing JoinID.
+// A backslash escapes the following character, a trailing backslash is kept.
+func SplitID(id string) []string {
+	parts := []string{}
+	var part strings.Builder
+	for i := 0; i < len(id); i++ {
+		switch {
+		case strings.HasPrefix(id[i:], idEscape) && i+1 < len(id):
+			i++
+			part.WriteByte(id[i])
+		case strings.HasPrefix(id[i:], Separator):
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(id[i])
+		}
+	}
+	return append(parts, part.String())
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCanonicalizeID(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    string
+	}{
+		{"canonical_uuid", "cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f", "cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f"},
+		{"upper_case_uuid", "CF9A6C8A-8B53-4A24-8E3F-8B9C0C1D2E3F", "cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f"},
+		{"whitespace", " cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f\n", "cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f"},
+		{"braces", "{cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f}", "cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f"},
+		{"without_hyphens", "cf9a6c8a8b534a248e3f8b9c0c1d2e3f", "cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f"},
+		{"urn_uuid", "urn:uuid:CF9A6C8A-8B53-4A24-8E3F-8B9C0C1D2E3F", "cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f"},
+		{"urn", "URN:stackit:project:cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f", "cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f"},
+		{"not_a_uuid", " Some-ID ", "Some-ID"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := CanonicalizeID(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeIDValue(t *testing.T) {
+	tests := []struct {
+		description string
+		input       types.String
+		expected    types.String
+	}{
+		{"value", types.StringValue("CF9A6C8A-8B53-4A24-8E3F-8B9C0C1D2E3F"), types.StringValue("cf9a6c8a-8b53-4a24-8e3f-8b9c0c1d2e3f")},
+		{"null", types.StringNull(), types.StringNull()},
+		{"unknown", types.StringUnknown(), types.StringUnknown()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := CanonicalizeIDValue(tt.input)
+			if !output.Equal(tt.expected) {
+				t.Fatalf("Expected %v, got %v", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestJoinID(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []string
+		expected    string
+	}{
+		{"plain", []string{"pid", "iid"}, "pid,iid"},
+		{"separator", []string{"pid", "a,b"}, `pid,a\,b`},
+		{"escape", []string{"pid", `a\b`}, `pid,a\\b`},
+		{"empty_part", []string{"pid", ""}, "pid,"},
+		{"single", []string{"pid"}, "pid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := JoinID(tt.input...)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+			diff := cmp.Diff(SplitID(output), tt.input)
+			if diff != "" {
+				t.Fatalf("Parts don't round trip: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSplitID(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    []string
+	}{
+		{"plain", "pid,iid", []string{"pid", "iid"}},
+		{"escaped_separator", `pid,a\,b`, []string{"pid", "a,b"}},
+		{"escaped_escape", `pid,a\\,b`, []string{"pid", `a\`, "b"}},
+		{"escaped_other", `pid,a\b`, []string{"pid", "ab"}},
+		{"trailing_escape", `pid,a\`, []string{"pid", `a\`}},
+		{"empty_parts", ",", []string{"", ""}},
+		{"empty", "", []string{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := SplitID(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Parts don't match: %s", diff)
+			}
+		})
+	}
+}
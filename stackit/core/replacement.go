@@ -0,0 +1,56 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WarnOnReplacement adds a warning to resp if the plan changes one of the string attributes in replaceAttributes,
+// which force the replacement of the resource. The warning names the computedAttributes, as they become unknown until
+// the new resource is created, so resources referencing them, e.g. a DNS record pointing to the dashboard URL of an
+// instance, are changed as well. The provider can't see which resources reference the attributes, Terraform lists them in the plan.
+// If strict is set, the warning is added as an error, see LogAndAddWarning.
+func WarnOnReplacement(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, strict bool, resourceType string, replaceAttributes, computedAttributes []string) { //nolint:gocritic // ModifyPlanRequest is passed by value like in ModifyPlan
+	// Nothing to replace on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	changed := []string{}
+	for _, name := range replaceAttributes {
+		var stateValue, planValue types.String
+		diags := req.State.GetAttribute(ctx, path.Root(name), &stateValue)
+		diags.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if planValue.IsUnknown() || planValue.Equal(stateValue) {
+			continue
+		}
+		changed = append(changed, fmt.Sprintf("`%s`", name))
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	computed := make([]string, len(computedAttributes))
+	for i, name := range computedAttributes {
+		computed[i] = fmt.Sprintf("`%s`", name)
+	}
+	summary, detail := replacementWarning(resourceType, changed, computed)
+	LogAndAddWarning(ctx, &resp.Diagnostics, strict, summary, detail)
+}
+
+func replacementWarning(resourceType string, changed, computed []string) (summary, detail string) {
+	return fmt.Sprintf("The %s will be replaced", resourceType),
+		fmt.Sprintf("Changing %s replaces the %s. The values of %s are unknown until the new %s is created, "+
+			"so all resources and outputs referencing them, e.g. a DNS record pointing to it, are updated or replaced as well. "+
+			"Review the plan for the affected resources.",
+			strings.Join(changed, ", "), resourceType, strings.Join(computed, ", "), resourceType)
+}
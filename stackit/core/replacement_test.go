@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWarnOnReplacement(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":          schema.StringAttribute{Required: true},
+			"description":   schema.StringAttribute{Optional: true},
+			"dashboard_url": schema.StringAttribute{Computed: true},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(context.Background())
+	value := func(name, description interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":          tftypes.NewValue(tftypes.String, name),
+			"description":   tftypes.NewValue(tftypes.String, description),
+			"dashboard_url": tftypes.NewValue(tftypes.String, "https://dashboard"),
+		})
+	}
+	tests := []struct {
+		description string
+		state       tftypes.Value
+		plan        tftypes.Value
+		expectWarn  bool
+	}{
+		{
+			"create",
+			tftypes.NewValue(objectType, nil),
+			value("name", nil),
+			false,
+		},
+		{
+			"destroy",
+			value("name", nil),
+			tftypes.NewValue(objectType, nil),
+			false,
+		},
+		{
+			"no_change",
+			value("name", nil),
+			value("name", nil),
+			false,
+		},
+		{
+			"in_place_change",
+			value("name", nil),
+			value("name", "description"),
+			false,
+		},
+		{
+			"replacement",
+			value("name", nil),
+			value("new-name", nil),
+			true,
+		},
+		{
+			"unknown",
+			value("name", nil),
+			value(tftypes.UnknownValue, nil),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: testSchema, Raw: tt.state},
+				Plan:  tfsdk.Plan{Schema: testSchema, Raw: tt.plan},
+			}
+			resp := &resource.ModifyPlanResponse{}
+			WarnOnReplacement(context.Background(), req, resp, false, "instance", []string{"name"}, []string{"dashboard_url"})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			hasWarning := resp.Diagnostics.WarningsCount() > 0
+			if hasWarning != tt.expectWarn {
+				t.Fatalf("Expected warning %t, got %v", tt.expectWarn, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestWarnOnReplacementStrict(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":          schema.StringAttribute{Required: true},
+			"dashboard_url": schema.StringAttribute{Computed: true},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(context.Background())
+	value := func(name string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":          tftypes.NewValue(tftypes.String, name),
+			"dashboard_url": tftypes.NewValue(tftypes.String, "https://dashboard"),
+		})
+	}
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: testSchema, Raw: value("name")},
+		Plan:  tfsdk.Plan{Schema: testSchema, Raw: value("new-name")},
+	}
+	resp := &resource.ModifyPlanResponse{}
+	WarnOnReplacement(context.Background(), req, resp, true, "instance", []string{"name"}, []string{"dashboard_url"})
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("Should have failed in strict mode")
+	}
+	if resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("Expected no warnings in strict mode, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// conflictRetryAttempts is the maximum number of calls made by RetryOnConflict.
+const conflictRetryAttempts = 8
+
+// conflictRetryMaxBackoff is the upper limit of the time RetryOnConflict waits between two calls.
+const conflictRetryMaxBackoff = 30 * time.Second
+
+// conflictRetryInitialBackoff is the time RetryOnConflict waits after the first conflict. It is doubled after each further conflict.
+// It's a variable so it can be reduced in tests.
+var conflictRetryInitialBackoff = 1 * time.Second
+
+// IsConflict checks if err is an API error with status code 409.
+func IsConflict(err error) bool {
+	var apiErr interface{ StatusCode() int }
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode() == http.StatusConflict
+}
+
+// RetryOnConflict calls fn until it doesn't return an API error with status code 409, using exponential backoff with jitter.
+// This is meant for APIs that reject concurrent changes of the same parent object, e.g. record sets of the same DNS zone.
+// Any other error, the last conflict error after conflictRetryAttempts calls, or the error of ctx is returned.
+func RetryOnConflict(ctx context.Context, fn func() error) error {
+	backoff := conflictRetryInitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || !IsConflict(err) || attempt >= conflictRetryAttempts {
+			return err
+		}
+
+		// Add up to 50% jitter, so that concurrent operations don't retry in lockstep
+		wait := backoff + time.Duration(rand.Int63n(int64(backoff)/2+1)) //nolint:gosec // jitter doesn't need a secure random number
+		tflog.Info(ctx, "API returned a conflict, retrying", map[string]interface{}{"attempt": attempt, "wait": wait.String()})
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+		backoff *= 2
+		if backoff > conflictRetryMaxBackoff {
+			backoff = conflictRetryMaxBackoff
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryOnConflict(t *testing.T) {
+	defer func(backoff time.Duration) { conflictRetryInitialBackoff = backoff }(conflictRetryInitialBackoff)
+	conflictRetryInitialBackoff = time.Millisecond
+	tests := []struct {
+		description   string
+		errs          []error
+		expectedCalls int
+		isValid       bool
+	}{
+		{
+			"success",
+			[]error{nil},
+			1,
+			true,
+		},
+		{
+			"conflicts_then_success",
+			[]error{&apiError{statusCode: http.StatusConflict}, fmt.Errorf("calling API: %w", &apiError{statusCode: http.StatusConflict}), nil},
+			3,
+			true,
+		},
+		{
+			"other_error",
+			[]error{&apiError{statusCode: http.StatusConflict}, &apiError{statusCode: http.StatusBadRequest}, nil},
+			2,
+			false,
+		},
+		{
+			"conflicts_exceed_attempts",
+			nil,
+			conflictRetryAttempts,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			calls := 0
+			err := RetryOnConflict(context.Background(), func() error {
+				calls++
+				if tt.errs == nil {
+					return &apiError{statusCode: http.StatusConflict}
+				}
+				return tt.errs[calls-1]
+			})
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if calls != tt.expectedCalls {
+				t.Fatalf("Expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestRetryOnConflictCanceled(t *testing.T) {
+	defer func(backoff time.Duration) { conflictRetryInitialBackoff = backoff }(conflictRetryInitialBackoff)
+	conflictRetryInitialBackoff = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := RetryOnConflict(ctx, func() error {
+		return &apiError{statusCode: http.StatusConflict}
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if !IsConflict(err) {
+		t.Fatalf("Expected the conflict error to be returned as well, got %v", err)
+	}
+}
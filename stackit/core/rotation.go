@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RotateCredentials plans the replacement of credentials that are older than the duration in their rotate_after attribute.
+// The age is counted from their created_at attribute, so both attributes have to be in the schema of the resource.
+// As Terraform only replaces resources if an attribute requiring replacement changes, created_at is planned as unknown.
+// The credentials are therefore rotated on the first apply after they expired, not at the time they expire.
+func RotateCredentials(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, now time.Time) { //nolint:gocritic // ModifyPlanRequest is passed by value like in ModifyPlan
+	// Nothing to rotate on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var rotateAfter, createdAt types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotate_after"), &rotateAfter)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("created_at"), &createdAt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if rotateAfter.IsNull() || rotateAfter.IsUnknown() || createdAt.IsNull() || createdAt.IsUnknown() {
+		return
+	}
+	due, err := rotationDue(createdAt.ValueString(), rotateAfter.ValueString(), now)
+	if err != nil {
+		LogAndAddError(ctx, &resp.Diagnostics, "Error planning the rotation of the credentials", err.Error())
+		return
+	}
+	if !due {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Credentials created at %s are older than %s, planning their rotation", createdAt.ValueString(), rotateAfter.ValueString()))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("created_at"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("created_at"))
+}
+
+// CreatedAt returns the value of the created_at attribute of credentials created at the given time.
+func CreatedAt(now time.Time) types.String {
+	return types.StringValue(now.UTC().Format(time.RFC3339))
+}
+
+// rotationDue returns whether credentials created at createdAt, in RFC 3339 format, are older than rotateAfter at the given time.
+func rotationDue(createdAt, rotateAfter string, now time.Time) (bool, error) {
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return false, fmt.Errorf("parsing created_at %q: %w", createdAt, err)
+	}
+	age, err := time.ParseDuration(rotateAfter)
+	if err != nil {
+		return false, fmt.Errorf("parsing rotate_after %q: %w", rotateAfter, err)
+	}
+	return !now.Before(created.Add(age)), nil
+}
@@ -0,0 +1,160 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRotationDue(t *testing.T) {
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		createdAt   string
+		rotateAfter string
+		expected    bool
+		isValid     bool
+	}{
+		{"not_due", "2023-08-31T12:00:00Z", "48h", false, true},
+		{"due", "2023-08-01T12:00:00Z", "720h", true, true},
+		{"exactly_due", "2023-08-31T12:00:00Z", "24h", true, true},
+		{"other_timezone", "2023-09-01T12:30:00+02:00", "1h", true, true},
+		{"invalid_created_at", "yesterday", "24h", false, false},
+		{"invalid_rotate_after", "2023-08-31T12:00:00Z", "1 day", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := rotationDue(tt.createdAt, tt.rotateAfter, now)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestRotateCredentials(t *testing.T) {
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rotate_after": schema.StringAttribute{Optional: true},
+			"created_at":   schema.StringAttribute{Computed: true},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(context.Background())
+	value := func(rotateAfter, createdAt interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"rotate_after": tftypes.NewValue(tftypes.String, rotateAfter),
+			"created_at":   tftypes.NewValue(tftypes.String, createdAt),
+		})
+	}
+	tests := []struct {
+		description  string
+		state        tftypes.Value
+		plan         tftypes.Value
+		expectRotate bool
+		isValid      bool
+	}{
+		{
+			"create",
+			tftypes.NewValue(objectType, nil),
+			value("24h", tftypes.UnknownValue),
+			false,
+			true,
+		},
+		{
+			"destroy",
+			value("24h", "2023-08-01T12:00:00Z"),
+			tftypes.NewValue(objectType, nil),
+			false,
+			true,
+		},
+		{
+			"rotate_after_not_set",
+			value(nil, "2023-08-01T12:00:00Z"),
+			value(nil, "2023-08-01T12:00:00Z"),
+			false,
+			true,
+		},
+		{
+			"created_at_not_set",
+			value("24h", nil),
+			value("24h", nil),
+			false,
+			true,
+		},
+		{
+			"not_due",
+			value("720h", "2023-08-31T12:00:00Z"),
+			value("720h", "2023-08-31T12:00:00Z"),
+			false,
+			true,
+		},
+		{
+			"due",
+			value("24h", "2023-08-01T12:00:00Z"),
+			value("24h", "2023-08-01T12:00:00Z"),
+			true,
+			true,
+		},
+		{
+			"due_after_rotate_after_changed",
+			value("720h", "2023-08-30T12:00:00Z"),
+			value("24h", "2023-08-30T12:00:00Z"),
+			true,
+			true,
+		},
+		{
+			"invalid_created_at",
+			value("24h", "yesterday"),
+			value("24h", "yesterday"),
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: testSchema, Raw: tt.state},
+				Plan:  tfsdk.Plan{Schema: testSchema, Raw: tt.plan},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: testSchema, Raw: tt.plan},
+			}
+			RotateCredentials(context.Background(), req, resp, now)
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			rotate := len(resp.RequiresReplace) > 0
+			if rotate != tt.expectRotate {
+				t.Fatalf("Expected rotation %t, got %t", tt.expectRotate, rotate)
+			}
+			if !rotate {
+				return
+			}
+			var createdAt types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("created_at"), &createdAt)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			if !createdAt.IsUnknown() {
+				t.Fatalf("Expected created_at to be planned as unknown, got %s", createdAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"time"
+)
+
+// Timeouts are the default timeouts of the wait handlers, set in the default_timeouts block of the provider.
+// A zero duration means that no default is configured for the operation and the resource's own default is used.
+type Timeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// CreateOr returns the configured default timeout for creations, or fallback if there is none.
+func (t Timeouts) CreateOr(fallback time.Duration) time.Duration {
+	return durationOr(t.Create, fallback)
+}
+
+// UpdateOr returns the configured default timeout for updates, or fallback if there is none.
+func (t Timeouts) UpdateOr(fallback time.Duration) time.Duration {
+	return durationOr(t.Update, fallback)
+}
+
+// DeleteOr returns the configured default timeout for deletions, or fallback if there is none.
+func (t Timeouts) DeleteOr(fallback time.Duration) time.Duration {
+	return durationOr(t.Delete, fallback)
+}
+
+func durationOr(d, fallback time.Duration) time.Duration {
+	if d <= 0 {
+		return fallback
+	}
+	return d
+}
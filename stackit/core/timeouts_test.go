@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeouts(t *testing.T) {
+	tests := []struct {
+		description    string
+		timeouts       Timeouts
+		expectedCreate time.Duration
+		expectedUpdate time.Duration
+		expectedDelete time.Duration
+	}{
+		{
+			"not_configured",
+			Timeouts{},
+			time.Minute,
+			time.Minute,
+			time.Minute,
+		},
+		{
+			"all_configured",
+			Timeouts{
+				Create: 30 * time.Minute,
+				Update: 20 * time.Minute,
+				Delete: 10 * time.Minute,
+			},
+			30 * time.Minute,
+			20 * time.Minute,
+			10 * time.Minute,
+		},
+		{
+			"partially_configured",
+			Timeouts{
+				Update: 20 * time.Minute,
+			},
+			time.Minute,
+			20 * time.Minute,
+			time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := tt.timeouts.CreateOr(time.Minute); got != tt.expectedCreate {
+				t.Errorf("CreateOr: expected %s, got %s", tt.expectedCreate, got)
+			}
+			if got := tt.timeouts.UpdateOr(time.Minute); got != tt.expectedUpdate {
+				t.Errorf("UpdateOr: expected %s, got %s", tt.expectedUpdate, got)
+			}
+			if got := tt.timeouts.DeleteOr(time.Minute); got != tt.expectedDelete {
+				t.Errorf("DeleteOr: expected %s, got %s", tt.expectedDelete, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// WaitError returns the error of a wait handler, telling apart interruptions from timeouts.
+// The wait handlers of the SDK report any done context as a timeout. If the operation was canceled,
+// e.g. because Terraform was interrupted with Ctrl-C, the cancellation is reported instead.
+func WaitError(ctx context.Context, err error) error {
+	if err == nil || !errors.Is(ctx.Err(), context.Canceled) {
+		return err
+	}
+	if !errors.Is(err, context.Canceled) {
+		err = errors.Join(err, ctx.Err())
+	}
+	return fmt.Errorf("operation was interrupted, the resource may still be changing in the background: %w", err)
+}
+
+// SleepWithContext pauses for d, or until ctx is done, in which case the error of ctx is returned.
+// Unlike the sleep before wait of the SDK's wait handlers, it doesn't delay interruptions.
+func SleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// SavePartialState is a best-effort save of a resource whose creation was started but didn't finish,
+// e.g. because it was interrupted or the wait timed out. Only the given identifying attributes are set,
+// which is enough for Read to refresh the resource. Terraform marks the resource as tainted,
+// so it is replaced on the next apply instead of being left behind untracked.
+func SavePartialState(ctx context.Context, state *tfsdk.State, attributes map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, value := range attributes {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+	return diags
+}
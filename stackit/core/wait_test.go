@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWaitError(t *testing.T) {
+	waitErr := errors.New("WaitWithContext() has timed out")
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	timedOutCtx, cancelTimeout := context.WithTimeout(context.Background(), 0)
+	defer cancelTimeout()
+	tests := []struct {
+		description    string
+		ctx            context.Context
+		err            error
+		expectCanceled bool
+	}{
+		{"no_error", canceledCtx, nil, false},
+		{"active_context", context.Background(), waitErr, false},
+		{"timed_out", timedOutCtx, waitErr, false},
+		{"canceled", canceledCtx, waitErr, true},
+		{"canceled_before_wait", canceledCtx, context.Canceled, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := WaitError(tt.ctx, tt.err)
+			if tt.err == nil {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected error to wrap %v, got %v", tt.err, err)
+			}
+			if errors.Is(err, context.Canceled) != tt.expectCanceled {
+				t.Fatalf("Expected cancellation to be reported: %t, got %v", tt.expectCanceled, err)
+			}
+		})
+	}
+}
+
+func TestSleepWithContext(t *testing.T) {
+	err := SleepWithContext(context.Background(), time.Millisecond)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	err = SleepWithContext(ctx, time.Minute)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Fatalf("Should have returned as soon as the context was canceled")
+	}
+}
+
+func TestSavePartialState(t *testing.T) {
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id":  schema.StringAttribute{Required: true},
+			"instance_id": schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+		},
+	}
+	state := tfsdk.State{
+		Schema: testSchema,
+		Raw:    tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil),
+	}
+	diags := SavePartialState(ctx, &state, map[string]string{
+		"project_id":  "pid",
+		"instance_id": "iid",
+	})
+	if diags.HasError() {
+		t.Fatalf("Should not have failed: %v", diags)
+	}
+	expected := map[string]types.String{
+		"project_id":  types.StringValue("pid"),
+		"instance_id": types.StringValue("iid"),
+		"name":        types.StringNull(),
+	}
+	for name, value := range expected {
+		var got types.String
+		diags = state.GetAttribute(ctx, path.Root(name), &got)
+		if diags.HasError() {
+			t.Fatalf("Getting %s: %v", name, diags)
+		}
+		if !got.Equal(value) {
+			t.Fatalf("Attribute %s: expected %v, got %v", name, value, got)
+		}
+	}
+}
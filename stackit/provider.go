@@ -2,31 +2,55 @@ package stackit
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	argusCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/services/argus/credential"
 	argusInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/services/argus/instance"
+	argusInstances "github.com/stackitcloud/terraform-provider-stackit/stackit/services/argus/instances"
 	argusScrapeConfig "github.com/stackitcloud/terraform-provider-stackit/stackit/services/argus/scrapeconfig"
+	dnsPtrRecord "github.com/stackitcloud/terraform-provider-stackit/stackit/services/dns/ptrrecord"
 	dnsRecordSet "github.com/stackitcloud/terraform-provider-stackit/stackit/services/dns/recordset"
+	dnsRecordSets "github.com/stackitcloud/terraform-provider-stackit/stackit/services/dns/recordsets"
 	dnsZone "github.com/stackitcloud/terraform-provider-stackit/stackit/services/dns/zone"
+	dnsZoneRecords "github.com/stackitcloud/terraform-provider-stackit/stackit/services/dns/zonerecords"
+	dnsZones "github.com/stackitcloud/terraform-provider-stackit/stackit/services/dns/zones"
 	logMeCredentials "github.com/stackitcloud/terraform-provider-stackit/stackit/services/logme/credentials"
 	logMeInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/services/logme/instance"
+	logMeInstances "github.com/stackitcloud/terraform-provider-stackit/stackit/services/logme/instances"
 	mariaDBCredentials "github.com/stackitcloud/terraform-provider-stackit/stackit/services/mariadb/credentials"
 	mariaDBInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/services/mariadb/instance"
+	mariaDBInstances "github.com/stackitcloud/terraform-provider-stackit/stackit/services/mariadb/instances"
 	openSearchCredentials "github.com/stackitcloud/terraform-provider-stackit/stackit/services/opensearch/credentials"
 	openSearchInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/services/opensearch/instance"
+	openSearchInstances "github.com/stackitcloud/terraform-provider-stackit/stackit/services/opensearch/instances"
+	postgresFlexBackups "github.com/stackitcloud/terraform-provider-stackit/stackit/services/postgresflex/backups"
+	postgresFlexFlavors "github.com/stackitcloud/terraform-provider-stackit/stackit/services/postgresflex/flavors"
 	postgresFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/services/postgresflex/instance"
 	postgresFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/services/postgresflex/user"
+	postgresFlexVersions "github.com/stackitcloud/terraform-provider-stackit/stackit/services/postgresflex/versions"
 	postgresCredentials "github.com/stackitcloud/terraform-provider-stackit/stackit/services/postgresql/credentials"
 	postgresInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/services/postgresql/instance"
+	postgresInstances "github.com/stackitcloud/terraform-provider-stackit/stackit/services/postgresql/instances"
+	postgresOfferings "github.com/stackitcloud/terraform-provider-stackit/stackit/services/postgresql/offerings"
 	rabbitMQCredentials "github.com/stackitcloud/terraform-provider-stackit/stackit/services/rabbitmq/credentials"
 	rabbitMQInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/services/rabbitmq/instance"
+	rabbitMQInstances "github.com/stackitcloud/terraform-provider-stackit/stackit/services/rabbitmq/instances"
 	redisCredentials "github.com/stackitcloud/terraform-provider-stackit/stackit/services/redis/credentials"
 	redisInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/services/redis/instance"
+	redisInstances "github.com/stackitcloud/terraform-provider-stackit/stackit/services/redis/instances"
 	resourceManagerProject "github.com/stackitcloud/terraform-provider-stackit/stackit/services/resourcemanager/project"
 	skeCluster "github.com/stackitcloud/terraform-provider-stackit/stackit/services/ske/cluster"
 	skeProject "github.com/stackitcloud/terraform-provider-stackit/stackit/services/ske/project"
@@ -35,6 +59,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -42,6 +67,12 @@ var (
 	_ provider.Provider = &Provider{}
 )
 
+// defaultSKEVersionExpirationWarningDays is used if ske_version_expiration_warning_days isn't set
+const defaultSKEVersionExpirationWarningDays = 30
+
+// serviceNames are the names of the services that can be configured individually, e.g. with a concurrency limit
+var serviceNames = []string{"argus", "dns", "logme", "mariadb", "opensearch", "postgresflex", "postgresql", "rabbitmq", "redis", "resourcemanager", "ske"}
+
 // Provider is the provider implementation.
 type Provider struct {
 	version string
@@ -62,21 +93,31 @@ func (p *Provider) Metadata(_ context.Context, _ provider.MetadataRequest, resp
 }
 
 type providerModel struct {
-	CredentialsFilePath           types.String `tfsdk:"credentials_path"`
-	ServiceAccountEmail           types.String `tfsdk:"service_account_email"`
-	Token                         types.String `tfsdk:"service_account_token"`
-	Region                        types.String `tfsdk:"region"`
-	DNSCustomEndpoint             types.String `tfsdk:"dns_custom_endpoint"`
-	PostgreSQLCustomEndpoint      types.String `tfsdk:"postgresql_custom_endpoint"`
-	PostgresFlexCustomEndpoint    types.String `tfsdk:"postgresflex_custom_endpoint"`
-	LogMeCustomEndpoint           types.String `tfsdk:"logme_custom_endpoint"`
-	RabbitMQCustomEndpoint        types.String `tfsdk:"rabbitmq_custom_endpoint"`
-	MariaDBCustomEndpoint         types.String `tfsdk:"mariadb_custom_endpoint"`
-	OpenSearchCustomEndpoint      types.String `tfsdk:"opensearch_custom_endpoint"`
-	RedisCustomEndpoint           types.String `tfsdk:"redis_custom_endpoint"`
-	ArgusCustomEndpoint           types.String `tfsdk:"argus_custom_endpoint"`
-	SKECustomEndpoint             types.String `tfsdk:"ske_custom_endpoint"`
-	ResourceManagerCustomEndpoint types.String `tfsdk:"resourcemanager_custom_endpoint"`
+	CredentialsFilePath             types.String `tfsdk:"credentials_path"`
+	ServiceAccountEmail             types.String `tfsdk:"service_account_email"`
+	Token                           types.String `tfsdk:"service_account_token"`
+	Region                          types.String `tfsdk:"region"`
+	DNSCustomEndpoint               types.String `tfsdk:"dns_custom_endpoint"`
+	PostgreSQLCustomEndpoint        types.String `tfsdk:"postgresql_custom_endpoint"`
+	PostgresFlexCustomEndpoint      types.String `tfsdk:"postgresflex_custom_endpoint"`
+	LogMeCustomEndpoint             types.String `tfsdk:"logme_custom_endpoint"`
+	RabbitMQCustomEndpoint          types.String `tfsdk:"rabbitmq_custom_endpoint"`
+	MariaDBCustomEndpoint           types.String `tfsdk:"mariadb_custom_endpoint"`
+	OpenSearchCustomEndpoint        types.String `tfsdk:"opensearch_custom_endpoint"`
+	RedisCustomEndpoint             types.String `tfsdk:"redis_custom_endpoint"`
+	ArgusCustomEndpoint             types.String `tfsdk:"argus_custom_endpoint"`
+	SKECustomEndpoint               types.String `tfsdk:"ske_custom_endpoint"`
+	ResourceManagerCustomEndpoint   types.String `tfsdk:"resourcemanager_custom_endpoint"`
+	ServiceConcurrency              types.Map    `tfsdk:"service_concurrency"`
+	Strict                          types.Bool   `tfsdk:"strict"`
+	DefaultTimeouts                 types.Object `tfsdk:"default_timeouts"`
+	SKEVersionExpirationWarningDays types.Int64  `tfsdk:"ske_version_expiration_warning_days"`
+}
+
+type defaultTimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
 }
 
 // Schema defines the provider-level schema for configuration data.
@@ -96,6 +137,20 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 		"argus_custom_endpoint":           "Custom endpoint for the Argus service",
 		"ske_custom_endpoint":             "Custom endpoint for the Kubernetes Engine (SKE) service",
 		"resourcemanager_custom_endpoint": "Custom endpoint for the Resource Manager service",
+		"service_concurrency": "Maximum number of simultaneous API requests per service, e.g. `{ dns = 5 }`. " +
+			"The limit applies to all resources and data sources of the service, regardless of Terraform's parallelism, and can be used to avoid rate limiting (HTTP 429) on bulk operations. A request counts towards the limit until its response has been read. " +
+			fmt.Sprintf("Services without a limit are not restricted. Supported services: %s", strings.Join(serviceNames, ", ")),
+		"strict": "If true, warnings emitted by the provider, e.g. about deprecated Kubernetes versions or record sets in a failed state, are reported as errors and fail the operation. " +
+			"Warnings emitted by Terraform itself are not affected. Defaults to `false`.",
+		"default_timeouts": "Default timeouts for waiting on the creation, update and deletion of resources, e.g. to raise them for all resources in slow regions. " +
+			"They apply to all resources that don't set the operation's timeout in a `timeouts` block of their own. " +
+			"Without a default, each resource uses its own timeout, e.g. 15 minutes for data service instances.",
+		"ske_version_expiration_warning_days": "Number of days before the expiration of a Kubernetes version from which planning an SKE cluster that uses it emits a warning, " +
+			"so that clusters are upgraded before they run an unsupported version. `0` disables the warning. " +
+			fmt.Sprintf("Defaults to `%d`.", defaultSKEVersionExpirationWarningDays),
+		"default_timeouts.create": "Default timeout for waiting on the creation of resources, e.g. `45m`.",
+		"default_timeouts.update": "Default timeout for waiting on the update of resources, e.g. `45m`.",
+		"default_timeouts.delete": "Default timeout for waiting on the deletion of resources, e.g. `45m`.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -110,6 +165,7 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			},
 			"service_account_token": schema.StringAttribute{
 				Optional:    true,
+				Sensitive:   true,
 				Description: descriptions["service_account_token"],
 			},
 			"region": schema.StringAttribute{
@@ -160,6 +216,54 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Optional:    true,
 				Description: descriptions["resourcemanager_custom_endpoint"],
 			},
+			"service_concurrency": schema.MapAttribute{
+				Optional:    true,
+				Description: descriptions["service_concurrency"],
+				ElementType: types.Int64Type,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(serviceNames...)),
+					mapvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"strict": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["strict"],
+			},
+			"ske_version_expiration_warning_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["ske_version_expiration_warning_days"],
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_timeouts": schema.SingleNestedBlock{
+				Description: descriptions["default_timeouts"],
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional:    true,
+						Description: descriptions["default_timeouts.create"],
+						Validators: []validator.String{
+							validate.Duration(),
+						},
+					},
+					"update": schema.StringAttribute{
+						Optional:    true,
+						Description: descriptions["default_timeouts.update"],
+						Validators: []validator.String{
+							validate.Duration(),
+						},
+					},
+					"delete": schema.StringAttribute{
+						Optional:    true,
+						Description: descriptions["default_timeouts.delete"],
+						Validators: []validator.String{
+							validate.Duration(),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -223,6 +327,27 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	if !(providerConfig.ResourceManagerCustomEndpoint.IsUnknown() || providerConfig.ResourceManagerCustomEndpoint.IsNull()) {
 		providerData.ResourceManagerCustomEndpoint = providerConfig.ResourceManagerCustomEndpoint.ValueString()
 	}
+	if !(providerConfig.Strict.IsUnknown() || providerConfig.Strict.IsNull()) {
+		providerData.Strict = providerConfig.Strict.ValueBool()
+	}
+	providerData.SKEVersionExpirationWarningDays = defaultSKEVersionExpirationWarningDays
+	if !(providerConfig.SKEVersionExpirationWarningDays.IsUnknown() || providerConfig.SKEVersionExpirationWarningDays.IsNull()) {
+		providerData.SKEVersionExpirationWarningDays = providerConfig.SKEVersionExpirationWarningDays.ValueInt64()
+	}
+	if !(providerConfig.DefaultTimeouts.IsUnknown() || providerConfig.DefaultTimeouts.IsNull()) {
+		var defaultTimeoutsConfig defaultTimeoutsModel
+		diags = providerConfig.DefaultTimeouts.As(ctx, &defaultTimeoutsConfig, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		defaultTimeouts, err := toTimeouts(&defaultTimeoutsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid default timeouts", err.Error())
+			return
+		}
+		providerData.DefaultTimeouts = defaultTimeouts
+	}
 	roundTripper, err := sdkauth.SetupAuth(sdkConfig)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -235,34 +360,88 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	// Make round tripper and custom endpoints available during DataSource and Resource
 	// type Configure methods.
 	providerData.RoundTripper = roundTripper
+	providerData.ServiceRoundTrippers = map[string]http.RoundTripper{}
+	if !(providerConfig.ServiceConcurrency.IsUnknown() || providerConfig.ServiceConcurrency.IsNull()) {
+		serviceConcurrency := map[string]int64{}
+		diags = providerConfig.ServiceConcurrency.ElementsAs(ctx, &serviceConcurrency, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for service, limit := range serviceConcurrency {
+			providerData.ServiceRoundTrippers[service] = core.NewConcurrencyLimitedRoundTripper(roundTripper, int(limit))
+		}
+	}
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
 }
 
+// toTimeouts parses the durations of the default_timeouts block. Durations that aren't set stay zero.
+func toTimeouts(model *defaultTimeoutsModel) (core.Timeouts, error) {
+	timeouts := core.Timeouts{}
+	durations := []struct {
+		name   string
+		value  types.String
+		target *time.Duration
+	}{
+		{"create", model.Create, &timeouts.Create},
+		{"update", model.Update, &timeouts.Update},
+		{"delete", model.Delete, &timeouts.Delete},
+	}
+	for _, d := range durations {
+		if d.value.IsNull() || d.value.IsUnknown() {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value.ValueString())
+		if err != nil {
+			return core.Timeouts{}, fmt.Errorf("parsing %s timeout: %w", d.name, err)
+		}
+		*d.target = duration
+	}
+	return timeouts, nil
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		dnsZone.NewZoneDataSource,
+		dnsZones.NewZonesDataSource,
 		dnsRecordSet.NewRecordSetDataSource,
+		dnsRecordSets.NewRecordSetsDataSource,
+		dnsZoneRecords.NewZoneExportDataSource,
 		postgresInstance.NewInstanceDataSource,
 		postgresCredentials.NewCredentialsDataSource,
+		postgresOfferings.NewOfferingsDataSource,
+		postgresInstances.NewInstancesDataSource,
 		logMeInstance.NewInstanceDataSource,
 		logMeCredentials.NewCredentialsDataSource,
+		logMeInstances.NewInstancesDataSource,
 		mariaDBInstance.NewInstanceDataSource,
 		mariaDBCredentials.NewCredentialsDataSource,
+		mariaDBInstances.NewInstancesDataSource,
 		openSearchInstance.NewInstanceDataSource,
 		openSearchCredentials.NewCredentialsDataSource,
+		openSearchInstances.NewInstancesDataSource,
 		rabbitMQInstance.NewInstanceDataSource,
 		rabbitMQCredentials.NewCredentialsDataSource,
+		rabbitMQInstances.NewInstancesDataSource,
 		redisInstance.NewInstanceDataSource,
 		redisCredentials.NewCredentialsDataSource,
+		redisInstances.NewInstancesDataSource,
 		argusInstance.NewInstanceDataSource,
+		argusInstances.NewInstancesDataSource,
 		argusScrapeConfig.NewScrapeConfigDataSource,
 		resourceManagerProject.NewProjectDataSource,
 		skeProject.NewProjectDataSource,
 		skeCluster.NewClusterDataSource,
 		postgresFlexInstance.NewInstanceDataSource,
 		postgresFlexUser.NewUserDataSource,
+		postgresFlexBackups.NewBackupsDataSource,
+		postgresFlexFlavors.NewFlavorsDataSource,
+		postgresFlexVersions.NewVersionsDataSource,
+		func() datasource.DataSource {
+			return &providerInfoDataSource{provider: p}
+		},
 	}
 }
 
@@ -271,6 +450,9 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		dnsZone.NewZoneResource,
 		dnsRecordSet.NewRecordSetResource,
+		dnsZoneRecords.NewZoneRecordsResource,
+		dnsZoneRecords.NewRecordSetBulkResource,
+		dnsPtrRecord.NewPtrRecordResource,
 		postgresInstance.NewInstanceResource,
 		postgresCredentials.NewCredentialsResource,
 		logMeInstance.NewInstanceResource,
@@ -0,0 +1,128 @@
+package stackit
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &providerInfoDataSource{}
+)
+
+type providerInfoModel struct {
+	Id          types.String `tfsdk:"id"` // needed by TF
+	Version     types.String `tfsdk:"version"`
+	Services    types.List   `tfsdk:"services"`
+	Resources   types.List   `tfsdk:"resources"`
+	DataSources types.List   `tfsdk:"data_sources"`
+}
+
+// providerInfoDataSource describes the provider itself. Unlike the other data sources, it doesn't call any API,
+// so it is part of the provider package, which knows the registered resources and data sources.
+type providerInfoDataSource struct {
+	provider *Provider
+}
+
+// Metadata returns the data source type name.
+func (d *providerInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *providerInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provider info data source schema. Describes the provider version in use and what it supports, " +
+			"e.g. to check in a precondition of a module that the provider has a resource type the module needs.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. Equals the provider version.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The version of the provider. E.g. `0.5.0`, or `dev` for local builds.",
+				Computed:    true,
+			},
+			"services": schema.ListAttribute{
+				Description: "The STACKIT services supported by the provider, sorted by name. E.g. `[\"argus\", \"dns\"]`",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"resources": schema.ListAttribute{
+				Description: "The resource types registered in the provider, sorted by name. E.g. `[\"stackit_dns_record_set\", \"stackit_dns_zone\"]`",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"data_sources": schema.ListAttribute{
+				Description: "The data source types registered in the provider, sorted by name. E.g. `[\"stackit_dns_record_set\", \"stackit_dns_zone\"]`",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *providerInfoDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	state, diags := d.provider.info(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Provider info read")
+}
+
+// info describes the provider, see providerInfoDataSource.
+func (p *Provider) info(ctx context.Context) (providerInfoModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resources := []string{}
+	for _, newResource := range p.Resources(ctx) {
+		metadataResp := resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "stackit"}, &metadataResp)
+		resources = append(resources, metadataResp.TypeName)
+	}
+	dataSources := []string{}
+	for _, newDataSource := range p.DataSources(ctx) {
+		metadataResp := datasource.MetadataResponse{}
+		newDataSource().Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "stackit"}, &metadataResp)
+		dataSources = append(dataSources, metadataResp.TypeName)
+	}
+
+	model := providerInfoModel{
+		Id:      types.StringValue(p.version),
+		Version: types.StringValue(p.version),
+	}
+	var d diag.Diagnostics
+	model.Services, d = toSortedList(serviceNames)
+	diags.Append(d...)
+	model.Resources, d = toSortedList(resources)
+	diags.Append(d...)
+	model.DataSources, d = toSortedList(dataSources)
+	diags.Append(d...)
+	return model, diags
+}
+
+func toSortedList(values []string) (types.List, diag.Diagnostics) {
+	sorted := make([]string, len(values))
+	copy(sorted, values)
+	sort.Strings(sorted)
+	elements := []attr.Value{}
+	for _, v := range sorted {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValue(types.StringType, elements)
+}
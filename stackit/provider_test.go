@@ -0,0 +1,186 @@
+package stackit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+)
+
+// sensitiveAttributes are the names of attributes that hold secrets and therefore have to be marked as sensitive.
+var sensitiveAttributes = map[string]bool{
+	"grafana_initial_admin_password": true,
+	"kube_config":                    true,
+	"password":                       true,
+	"uri":                            true,
+}
+
+func TestSensitiveAttributes(t *testing.T) {
+	ctx := context.Background()
+	p := &Provider{}
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		metadataResp := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "stackit"}, &metadataResp)
+		schemaResp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		checkResourceAttributes(t, metadataResp.TypeName, schemaResp.Schema.Attributes)
+	}
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		metadataResp := datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "stackit"}, &metadataResp)
+		schemaResp := datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+		checkDataSourceAttributes(t, metadataResp.TypeName, schemaResp.Schema.Attributes)
+	}
+}
+
+func checkResourceAttributes(t *testing.T, path string, attributes map[string]schema.Attribute) {
+	t.Helper()
+	for name, attribute := range attributes {
+		attributePath := path + "." + name
+		if sensitiveAttributes[name] && !attribute.IsSensitive() {
+			t.Errorf("Attribute %s is not marked as sensitive", attributePath)
+		}
+		switch a := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			checkResourceAttributes(t, attributePath, a.Attributes)
+		case schema.ListNestedAttribute:
+			checkResourceAttributes(t, attributePath, a.NestedObject.Attributes)
+		case schema.SetNestedAttribute:
+			checkResourceAttributes(t, attributePath, a.NestedObject.Attributes)
+		case schema.MapNestedAttribute:
+			checkResourceAttributes(t, attributePath, a.NestedObject.Attributes)
+		}
+	}
+}
+
+func checkDataSourceAttributes(t *testing.T, path string, attributes map[string]datasourceschema.Attribute) {
+	t.Helper()
+	for name, attribute := range attributes {
+		attributePath := path + "." + name
+		if sensitiveAttributes[name] && !attribute.IsSensitive() {
+			t.Errorf("Attribute %s is not marked as sensitive", attributePath)
+		}
+		switch a := attribute.(type) {
+		case datasourceschema.SingleNestedAttribute:
+			checkDataSourceAttributes(t, attributePath, a.Attributes)
+		case datasourceschema.ListNestedAttribute:
+			checkDataSourceAttributes(t, attributePath, a.NestedObject.Attributes)
+		case datasourceschema.SetNestedAttribute:
+			checkDataSourceAttributes(t, attributePath, a.NestedObject.Attributes)
+		case datasourceschema.MapNestedAttribute:
+			checkDataSourceAttributes(t, attributePath, a.NestedObject.Attributes)
+		}
+	}
+}
+
+func TestToTimeouts(t *testing.T) {
+	tests := []struct {
+		description string
+		input       defaultTimeoutsModel
+		expected    core.Timeouts
+		isValid     bool
+	}{
+		{
+			"not_set",
+			defaultTimeoutsModel{
+				Create: types.StringNull(),
+				Update: types.StringNull(),
+				Delete: types.StringNull(),
+			},
+			core.Timeouts{},
+			true,
+		},
+		{
+			"all_set",
+			defaultTimeoutsModel{
+				Create: types.StringValue("45m"),
+				Update: types.StringValue("1h"),
+				Delete: types.StringValue("90s"),
+			},
+			core.Timeouts{
+				Create: 45 * time.Minute,
+				Update: time.Hour,
+				Delete: 90 * time.Second,
+			},
+			true,
+		},
+		{
+			"partially_set",
+			defaultTimeoutsModel{
+				Create: types.StringValue("45m"),
+				Update: types.StringNull(),
+				Delete: types.StringUnknown(),
+			},
+			core.Timeouts{
+				Create: 45 * time.Minute,
+			},
+			true,
+		},
+		{
+			"invalid_duration",
+			defaultTimeoutsModel{
+				Create: types.StringValue("45"),
+				Update: types.StringNull(),
+				Delete: types.StringNull(),
+			},
+			core.Timeouts{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toTimeouts(&tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && output != tt.expected {
+				t.Fatalf("Data does not match: expected %+v, got %+v", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestProviderInfo(t *testing.T) {
+	ctx := context.Background()
+	p := &Provider{version: "1.2.3"}
+	info, diags := p.info(ctx)
+	if diags.HasError() {
+		t.Fatalf("Should not have failed: %v", diags)
+	}
+	if info.Version.ValueString() != "1.2.3" {
+		t.Errorf("Version is %q, expected %q", info.Version.ValueString(), "1.2.3")
+	}
+	checkSortedList(t, "services", info.Services, "dns")
+	checkSortedList(t, "resources", info.Resources, "stackit_ske_cluster")
+	checkSortedList(t, "data_sources", info.DataSources, "stackit_provider_info")
+}
+
+func checkSortedList(t *testing.T, name string, list types.List, contains string) {
+	found := false
+	previous := ""
+	for i, element := range list.Elements() {
+		value := element.(types.String).ValueString()
+		if i > 0 && value < previous {
+			t.Errorf("%s: %q is after %q, expected list to be sorted", name, value, previous)
+		}
+		if value == contains {
+			found = true
+		}
+		previous = value
+	}
+	if !found {
+		t.Errorf("%s: %q not found", name, contains)
+	}
+}
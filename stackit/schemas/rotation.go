@@ -0,0 +1,35 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// RotateAfter returns the optional attribute with the age after which credentials are rotated, see core.RotateCredentials.
+// Changing it doesn't rotate the credentials, it only changes when they are rotated next.
+func RotateAfter() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Age after which the credentials are rotated, as a duration like `720h`. " +
+			"Once the credentials are older, the next plan replaces them: new credentials are created and the old ones are deleted. " +
+			"The age is counted from `created_at`.",
+		Optional: true,
+		Validators: []validator.String{
+			validate.Duration(),
+		},
+	}
+}
+
+// CreatedAt returns the computed attribute with the time at which credentials were created, from which their age is counted.
+func CreatedAt() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Time at which the credentials were created, in RFC 3339 format. " +
+			"For imported credentials, and for credentials created with earlier provider versions, it is the time at which they were first read.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
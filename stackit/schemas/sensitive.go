@@ -0,0 +1,40 @@
+// Package schemas contains constructors for schema attributes that have to be defined the same way across resources and data sources.
+package schemas
+
+import (
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SensitiveComputedString returns a computed string attribute holding a secret returned by the API,
+// e.g. a password or a connection URI containing one. The value is not shown in plans and in the CLI output.
+func SensitiveComputedString(description string, planModifiers ...planmodifier.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:   description,
+		Computed:      true,
+		Sensitive:     true,
+		PlanModifiers: planModifiers,
+	}
+}
+
+// SensitiveRequiredString returns a required string attribute holding a secret set by the user, e.g. a password.
+// The value is not shown in plans and in the CLI output.
+func SensitiveRequiredString(description string, validators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Required:    true,
+		Sensitive:   true,
+		Validators:  validators,
+	}
+}
+
+// DataSourceSensitiveComputedString is the data source counterpart of SensitiveComputedString.
+func DataSourceSensitiveComputedString(description string) datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Description: description,
+		Computed:    true,
+		Sensitive:   true,
+	}
+}
@@ -3,7 +3,6 @@ package argus_test
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -151,6 +150,11 @@ func TestAccResource(t *testing.T) {
 					  	instance_id = stackit_argus_scrapeconfig.scrapeconfig.instance_id
 					  	name        = stackit_argus_scrapeconfig.scrapeconfig.name
 					}
+
+					data "stackit_argus_instances" "instances" {
+					  	project_id  = stackit_argus_instance.instance.project_id
+					  	name_prefix = stackit_argus_instance.instance.name
+					}
 					`,
 					resourceConfig(instanceResource["name"], scrapeConfigResource["urls"], scrapeConfigResource["saml2_enable_url_parameters"]),
 				),
@@ -168,6 +172,14 @@ func TestAccResource(t *testing.T) {
 						"stackit_argus_instance.instance", "instance_id",
 						"data.stackit_argus_instance.instance", "instance_id",
 					),
+					// Instances data
+					resource.TestCheckResourceAttr("data.stackit_argus_instances.instances", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"stackit_argus_instance.instance", "instance_id",
+						"data.stackit_argus_instances.instances", "instances.0.instance_id",
+					),
+					resource.TestCheckResourceAttr("data.stackit_argus_instances.instances", "instances.0.name", instanceResource["name"]),
+					resource.TestCheckResourceAttr("data.stackit_argus_instances.instances", "instances.0.plan_name", instanceResource["plan_name"]),
 					// scrape config data
 					resource.TestCheckResourceAttrPair(
 						"stackit_argus_scrapeconfig.scrapeconfig", "project_id",
@@ -325,7 +337,7 @@ func testAccCheckArgusDestroy(s *terraform.State) error {
 			continue
 		}
 		// instance terraform ID: = "[project_id],[instance_id],[name]"
-		instanceId := strings.Split(rs.Primary.ID, core.Separator)[1]
+		instanceId := core.SplitID(rs.Primary.ID)[1]
 		instancesToDestroy = append(instancesToDestroy, instanceId)
 	}
 
@@ -3,11 +3,11 @@ package argus
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -16,6 +16,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/argus"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 )
 
@@ -26,11 +27,12 @@ var (
 )
 
 type Model struct {
-	Id         types.String `tfsdk:"id"`
-	ProjectId  types.String `tfsdk:"project_id"`
-	InstanceId types.String `tfsdk:"instance_id"`
-	Username   types.String `tfsdk:"username"`
-	Password   types.String `tfsdk:"password"`
+	Id                types.String `tfsdk:"id"`
+	ProjectId         types.String `tfsdk:"project_id"`
+	InstanceId        types.String `tfsdk:"instance_id"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	RotateWhenChanged types.Map    `tfsdk:"rotate_when_changed"`
 }
 
 // NewCredentialResource is a helper function to simplify the provider implementation.
@@ -65,12 +67,12 @@ func (r *credentialResource) Configure(_ context.Context, req resource.Configure
 	var err error
 	if providerData.ArgusCustomEndpoint != "" {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithEndpoint(providerData.ArgusCustomEndpoint),
 		)
 	} else {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -84,6 +86,8 @@ func (r *credentialResource) Configure(_ context.Context, req resource.Configure
 
 func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Argus credential resource schema. The credential can be used to push and read metrics and logs of an Argus instance. " +
+			"Changing any value of `rotate_when_changed` creates a new credential and deletes the previous one.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Terraform's internal resource ID.",
@@ -119,15 +123,17 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
-			"password": schema.StringAttribute{
-				Description: "Credential password",
-				Computed:    true,
-				Sensitive:   true,
-				Validators: []validator.String{
-					stringvalidator.LengthAtLeast(1),
-				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+			"password": schemas.SensitiveComputedString(
+				"Credential password",
+				stringplanmodifier.UseStateForUnknown(),
+			),
+			"rotate_when_changed": schema.MapAttribute{
+				Description: "A map of arbitrary key/value pairs that will force a rotation of the credential when changed. " +
+					"A new credential is created and the old one is invalidated.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
 				},
 			},
 		},
@@ -182,7 +188,7 @@ func mapFields(r *argus.Credential, model *Model) error {
 		userName,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.Username = types.StringPointerValue(r.Username)
 	model.Password = types.StringPointerValue(r.Password)
@@ -202,6 +208,12 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	userName := model.Username.ValueString()
 	_, err := r.client.GetCredential(ctx, instanceId, projectId, userName).Execute()
 	if err != nil {
+		// The credential is also gone if the instance was deleted
+		if core.IsNotFound(err) {
+			tflog.Warn(ctx, "ARGUS credential not found, removing it from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading credential", fmt.Sprintf("Project id = %s, instance id = %s, username = %s: %v", projectId, instanceId, userName, err))
 		return
 	}
@@ -229,6 +241,10 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	userName := model.Username.ValueString()
 	_, err := r.client.DeleteCredential(ctx, instanceId, projectId, userName).Execute()
 	if err != nil {
+		if core.IsNotFound(err) {
+			tflog.Info(ctx, "ARGUS credential already deleted")
+			return
+		}
 		resp.Diagnostics.AddError("Error deleting credential", "project id = "+projectId+", instance id = "+instanceId+", username = "+userName+", "+err.Error())
 		return
 	}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/argus"
@@ -22,12 +23,31 @@ func TestMapFields(t *testing.T) {
 				Username: utils.Ptr("username"),
 				Password: utils.Ptr("password"),
 			},
+			Model{
+				Id:                types.StringValue("pid,iid,username"),
+				ProjectId:         types.StringValue("pid"),
+				InstanceId:        types.StringValue("iid"),
+				Username:          types.StringValue("username"),
+				Password:          types.StringValue("password"),
+				RotateWhenChanged: types.MapNull(types.StringType),
+			},
+			true,
+		},
+		{
+			"rotate_when_changed_kept",
+			&argus.Credential{
+				Username: utils.Ptr("username"),
+				Password: utils.Ptr("password"),
+			},
 			Model{
 				Id:         types.StringValue("pid,iid,username"),
 				ProjectId:  types.StringValue("pid"),
 				InstanceId: types.StringValue("iid"),
 				Username:   types.StringValue("username"),
 				Password:   types.StringValue("password"),
+				RotateWhenChanged: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"rotation": types.StringValue("1"),
+				}),
 			},
 			true,
 		},
@@ -56,8 +76,9 @@ func TestMapFields(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
 			state := &Model{
-				ProjectId:  tt.expected.ProjectId,
-				InstanceId: tt.expected.InstanceId,
+				ProjectId:         tt.expected.ProjectId,
+				InstanceId:        tt.expected.InstanceId,
+				RotateWhenChanged: tt.expected.RotateWhenChanged,
 			}
 			err := mapFields(tt.input, state)
 			if !tt.isValid && err == nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/argus"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 )
 
@@ -20,6 +21,37 @@ var (
 	_ datasource.DataSource = &instanceDataSource{}
 )
 
+// DataSourceModel is the data source model. delete_scrape_configs only affects the deletion of the instance and is left out.
+type DataSourceModel struct {
+	Id                                 types.String `tfsdk:"id"` // needed by TF
+	ProjectId                          types.String `tfsdk:"project_id"`
+	InstanceId                         types.String `tfsdk:"instance_id"`
+	Name                               types.String `tfsdk:"name"`
+	PlanName                           types.String `tfsdk:"plan_name"`
+	PlanId                             types.String `tfsdk:"plan_id"`
+	Parameters                         types.Map    `tfsdk:"parameters"`
+	EffectiveParameters                types.Map    `tfsdk:"effective_parameters"`
+	DashboardURL                       types.String `tfsdk:"dashboard_url"`
+	IsUpdatable                        types.Bool   `tfsdk:"is_updatable"`
+	GrafanaURL                         types.String `tfsdk:"grafana_url"`
+	GrafanaPublicReadAccess            types.Bool   `tfsdk:"grafana_public_read_access"`
+	GrafanaInitialAdminPassword        types.String `tfsdk:"grafana_initial_admin_password"`
+	GrafanaInitialAdminUser            types.String `tfsdk:"grafana_initial_admin_user"`
+	MetricsRetentionDays               types.Int64  `tfsdk:"metrics_retention_days"`
+	MetricsRetentionDays5mDownsampling types.Int64  `tfsdk:"metrics_retention_days_5m_downsampling"`
+	MetricsRetentionDays1hDownsampling types.Int64  `tfsdk:"metrics_retention_days_1h_downsampling"`
+	MetricsURL                         types.String `tfsdk:"metrics_url"`
+	MetricsPushURL                     types.String `tfsdk:"metrics_push_url"`
+	TargetsURL                         types.String `tfsdk:"targets_url"`
+	AlertingURL                        types.String `tfsdk:"alerting_url"`
+	LogsURL                            types.String `tfsdk:"logs_url"`
+	LogsPushURL                        types.String `tfsdk:"logs_push_url"`
+	JaegerTracesURL                    types.String `tfsdk:"jaeger_traces_url"`
+	JaegerUIURL                        types.String `tfsdk:"jaeger_ui_url"`
+	OtlpTracesURL                      types.String `tfsdk:"otlp_traces_url"`
+	ZipkinSpansURL                     types.String `tfsdk:"zipkin_spans_url"`
+}
+
 // NewInstanceDataSource is a helper function to simplify the provider implementation.
 func NewInstanceDataSource() datasource.DataSource {
 	return &instanceDataSource{}
@@ -52,12 +84,12 @@ func (d *instanceDataSource) Configure(_ context.Context, req datasource.Configu
 
 	if providerData.ArgusCustomEndpoint != "" {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithEndpoint(providerData.ArgusCustomEndpoint),
 		)
 	} else {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -83,7 +115,7 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "STACKIT project ID to which the instance is associated.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -91,7 +123,7 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "The Argus instance ID.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -123,6 +155,11 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"effective_parameters": schema.MapAttribute{
+				Description: "All parameters of the instance, including the ones set by the API. Same as `parameters`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"dashboard_url": schema.StringAttribute{
 				Description: "Specifies Argus instance dashboard URL.",
 				Computed:    true,
@@ -143,11 +180,7 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Specifies an initial Grafana admin username.",
 				Computed:    true,
 			},
-			"grafana_initial_admin_password": schema.StringAttribute{
-				Description: "Specifies an initial Grafana admin password.",
-				Computed:    true,
-				Sensitive:   true,
-			},
+			"grafana_initial_admin_password": schemas.DataSourceSensitiveComputedString("Specifies an initial Grafana admin password."),
 			"metrics_retention_days": schema.Int64Attribute{
 				Description: "Specifies for how many days the raw metrics are kept.",
 				Computed:    true,
@@ -202,12 +235,15 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 
 // Read refreshes the Terraform state with the latest data.
 func (d *instanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var state Model
+	var state DataSourceModel
 	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	state.InstanceId = core.CanonicalizeIDValue(state.InstanceId)
 	projectId := state.ProjectId.ValueString()
 	instanceId := state.InstanceId.ValueString()
 	instanceResponse, err := d.client.GetInstance(ctx, instanceId, projectId).Execute()
@@ -216,14 +252,53 @@ func (d *instanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	err = mapFields(ctx, instanceResponse, &state)
+	model := Model{
+		ProjectId:  state.ProjectId,
+		InstanceId: state.InstanceId,
+	}
+	err = mapFields(ctx, instanceResponse, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &diags, "Mapping fields", err.Error())
 		return
 	}
+	// The data source has no configured parameters to filter by, so it exposes all of them
+	model.Parameters = model.EffectiveParameters
+	state = toDataSourceModel(&model)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+func toDataSourceModel(model *Model) DataSourceModel {
+	return DataSourceModel{
+		Id:                                 model.Id,
+		ProjectId:                          model.ProjectId,
+		InstanceId:                         model.InstanceId,
+		Name:                               model.Name,
+		PlanName:                           model.PlanName,
+		PlanId:                             model.PlanId,
+		Parameters:                         model.Parameters,
+		EffectiveParameters:                model.EffectiveParameters,
+		DashboardURL:                       model.DashboardURL,
+		IsUpdatable:                        model.IsUpdatable,
+		GrafanaURL:                         model.GrafanaURL,
+		GrafanaPublicReadAccess:            model.GrafanaPublicReadAccess,
+		GrafanaInitialAdminPassword:        model.GrafanaInitialAdminPassword,
+		GrafanaInitialAdminUser:            model.GrafanaInitialAdminUser,
+		MetricsRetentionDays:               model.MetricsRetentionDays,
+		MetricsRetentionDays5mDownsampling: model.MetricsRetentionDays5mDownsampling,
+		MetricsRetentionDays1hDownsampling: model.MetricsRetentionDays1hDownsampling,
+		MetricsURL:                         model.MetricsURL,
+		MetricsPushURL:                     model.MetricsPushURL,
+		TargetsURL:                         model.TargetsURL,
+		AlertingURL:                        model.AlertingURL,
+		LogsURL:                            model.LogsURL,
+		LogsPushURL:                        model.LogsPushURL,
+		JaegerTracesURL:                    model.JaegerTracesURL,
+		JaegerUIURL:                        model.JaegerUIURL,
+		OtlpTracesURL:                      model.OtlpTracesURL,
+		ZipkinSpansURL:                     model.ZipkinSpansURL,
+	}
+}
@@ -0,0 +1,11 @@
+package argus
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/testutil/modeltest"
+)
+
+func TestToDataSourceModel(t *testing.T) {
+	modeltest.CheckDataSourceModel(t, toDataSourceModel, "delete_scrape_configs")
+}
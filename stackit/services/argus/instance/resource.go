@@ -2,6 +2,7 @@ package argus
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,22 +13,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/argus"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 )
 
+// serviceEnablementHint describes how Argus is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate Argus for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &instanceResource{}
-	_ resource.ResourceWithConfigure   = &instanceResource{}
-	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.Resource                 = &instanceResource{}
+	_ resource.ResourceWithConfigure    = &instanceResource{}
+	_ resource.ResourceWithImportState  = &instanceResource{}
+	_ resource.ResourceWithModifyPlan   = &instanceResource{}
+	_ resource.ResourceWithUpgradeState = &instanceResource{}
 )
 
 type Model struct {
@@ -38,6 +49,7 @@ type Model struct {
 	PlanName                           types.String `tfsdk:"plan_name"`
 	PlanId                             types.String `tfsdk:"plan_id"`
 	Parameters                         types.Map    `tfsdk:"parameters"`
+	EffectiveParameters                types.Map    `tfsdk:"effective_parameters"`
 	DashboardURL                       types.String `tfsdk:"dashboard_url"`
 	IsUpdatable                        types.Bool   `tfsdk:"is_updatable"`
 	GrafanaURL                         types.String `tfsdk:"grafana_url"`
@@ -57,6 +69,7 @@ type Model struct {
 	JaegerUIURL                        types.String `tfsdk:"jaeger_ui_url"`
 	OtlpTracesURL                      types.String `tfsdk:"otlp_traces_url"`
 	ZipkinSpansURL                     types.String `tfsdk:"zipkin_spans_url"`
+	DeleteScrapeConfigs                types.Bool   `tfsdk:"delete_scrape_configs"`
 }
 
 // NewInstanceResource is a helper function to simplify the provider implementation.
@@ -66,7 +79,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *argus.APIClient
+	client          *argus.APIClient
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -91,12 +105,12 @@ func (r *instanceResource) Configure(_ context.Context, req resource.ConfigureRe
 	var err error
 	if providerData.ArgusCustomEndpoint != "" {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithEndpoint(providerData.ArgusCustomEndpoint),
 		)
 	} else {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -106,11 +120,14 @@ func (r *instanceResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
 }
 
 // Schema defines the schema for the resource.
 func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 only tracks the configured parameters, see UpgradeState
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Terraform's internal resource ID.",
@@ -168,8 +185,12 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"parameters": schema.MapAttribute{
-				Description: "Additional parameters.",
+				Description: "Additional parameters. Only the configured keys are tracked, parameters added by the API are available in `effective_parameters`.",
 				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"effective_parameters": schema.MapAttribute{
+				Description: "All parameters of the instance, including the ones set by the API.",
 				Computed:    true,
 				ElementType: types.StringType,
 				PlanModifiers: []planmodifier.Map{
@@ -196,11 +217,7 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Specifies an initial Grafana admin username.",
 				Computed:    true,
 			},
-			"grafana_initial_admin_password": schema.StringAttribute{
-				Description: "Specifies an initial Grafana admin password.",
-				Computed:    true,
-				Sensitive:   true,
-			},
+			"grafana_initial_admin_password": schemas.SensitiveComputedString("Specifies an initial Grafana admin password."),
 			"metrics_retention_days": schema.Int64Attribute{
 				Description: "Specifies for how many days the raw metrics are kept.",
 				Computed:    true,
@@ -249,10 +266,37 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"zipkin_spans_url": schema.StringAttribute{
 				Computed: true,
 			},
+			"delete_scrape_configs": schema.BoolAttribute{
+				Description: "If true, all scrape configs of the instance, including the ones not managed by Terraform, are deleted before the instance is deleted. " +
+					"Scrape configs managed by `stackit_argus_scrapeconfig` resources are deleted by Terraform before the instance anyway. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
+// ModifyPlan marks effective_parameters as unknown if the parameters change,
+// as the API returns the new effective parameters only after the update.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planParameters, stateParameters types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parameters"), &planParameters)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("parameters"), &stateParameters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planParameters.Equal(stateParameters) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_parameters"), types.MapUnknown(types.StringType))...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
@@ -278,6 +322,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*payload).Execute()
 	if err != nil {
+		err = core.ServiceEnablementError(err, "Argus", projectId, serviceEnablementHint)
 		resp.Diagnostics.AddError("Error creating instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
@@ -286,9 +331,15 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		resp.Diagnostics.AddError("Error creating instance", "API didn't return an instance id")
 		return
 	}
-	wr, err := argus.CreateInstanceWaitHandler(ctx, r.client, *instanceId, projectId).SetTimeout(20 * time.Minute).WaitWithContext(ctx)
+	wr, err := argus.CreateInstanceWaitHandler(ctx, r.client, *instanceId, projectId).SetTimeout(r.defaultTimeouts.CreateOr(20 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
+		resp.Diagnostics.AddError("Error creating instance", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
+		// Track the instance, so it isn't left behind if the creation was interrupted or timed out
+		resp.Diagnostics.Append(core.SavePartialState(ctx, &resp.State, map[string]string{
+			"id":          core.JoinID(projectId, *instanceId),
+			"project_id":  projectId,
+			"instance_id": *instanceId,
+		})...)
 		return
 	}
 	got, ok := wr.(*argus.InstanceResponse)
@@ -366,9 +417,9 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		resp.Diagnostics.AddError("Error updating instance", "project id = "+projectId+", instance Id = "+instanceId+", "+err.Error())
 		return
 	}
-	wr, err := argus.UpdateInstanceWaitHandler(ctx, r.client, instanceId, projectId).SetTimeout(20 * time.Minute).WaitWithContext(ctx)
+	wr, err := argus.UpdateInstanceWaitHandler(ctx, r.client, instanceId, projectId).SetTimeout(r.defaultTimeouts.UpdateOr(20 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
+		resp.Diagnostics.AddError("Error updating instance", fmt.Sprintf("Instance update waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*argus.InstanceResponse)
@@ -399,15 +450,23 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
 
+	if model.DeleteScrapeConfigs.ValueBool() {
+		err := deleteScrapeConfigs(ctx, r.client, projectId, instanceId, r.defaultTimeouts.DeleteOr(1*time.Minute))
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Deleting scrape configs: %v", err))
+			return
+		}
+	}
+
 	// Delete existing instance
 	_, err := r.client.DeleteInstance(ctx, instanceId, projectId).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting instance", "project id = "+projectId+", instance Id = "+instanceId+", "+err.Error())
 		return
 	}
-	_, err = argus.DeleteInstanceWaitHandler(ctx, r.client, instanceId, projectId).SetTimeout(10 * time.Minute).WaitWithContext(ctx)
+	_, err = argus.DeleteInstanceWaitHandler(ctx, r.client, instanceId, projectId).SetTimeout(r.defaultTimeouts.DeleteOr(10 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
+		resp.Diagnostics.AddError("Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 }
@@ -415,7 +474,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -427,6 +486,66 @@ func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportS
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_scrape_configs"), false)...)
+}
+
+// UpgradeState upgrades the state of older versions of the resource.
+func (r *instanceResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeStateV0},
+	}
+}
+
+// upgradeStateV0 upgrades the state of version 0, where parameters was Optional and Computed and held all parameters of the instance,
+// including the ones set by the API. They are moved to effective_parameters. The configuration isn't available while upgrading,
+// so parameters is set to null; the configured keys are tracked again after the next apply, which sends the values the instance already has.
+func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state map[string]interface{}
+	err := json.Unmarshal(req.RawState.JSON, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error upgrading state", fmt.Sprintf("Decoding the state of version 0: %v", err))
+		return
+	}
+	state["effective_parameters"] = state["parameters"]
+	state["parameters"] = nil
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error upgrading state", fmt.Sprintf("Encoding the state of version 1: %v", err))
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
+// deleteScrapeConfigs deletes all scrape configs of the instance.
+// Scrape configs that are already gone, e.g. because they were deleted by Terraform in the meantime, are skipped.
+func deleteScrapeConfigs(ctx context.Context, client *argus.APIClient, projectId, instanceId string, timeout time.Duration) error {
+	scResp, err := client.GetScrapeConfigs(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		return fmt.Errorf("listing scrape configs: %w", err)
+	}
+	if scResp.Data == nil {
+		return nil
+	}
+	for _, sc := range *scResp.Data {
+		if sc.JobName == nil {
+			continue
+		}
+		scName := *sc.JobName
+		_, err = client.DeleteScrapeConfig(ctx, instanceId, scName, projectId).Execute()
+		if err != nil {
+			if core.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("deleting scrape config %s: %w", scName, err)
+		}
+		_, err = argus.DeleteScrapeConfigWaitHandler(ctx, client, instanceId, scName, projectId).SetTimeout(timeout).WaitWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("deleting scrape config %s: waiting: %w", scName, core.WaitError(ctx, err))
+		}
+		tflog.Info(ctx, "ARGUS scrape config deleted", map[string]interface{}{"scrape_config_name": scName})
+	}
+	return nil
 }
 
 func mapFields(ctx context.Context, r *argus.InstanceResponse, model *Model) error {
@@ -450,50 +569,79 @@ func mapFields(ctx context.Context, r *argus.InstanceResponse, model *Model) err
 		instanceId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.InstanceId = types.StringValue(instanceId)
 	model.PlanName = types.StringPointerValue(r.PlanName)
 	model.PlanId = types.StringPointerValue(r.PlanId)
 	model.Name = types.StringPointerValue(r.Name)
 
-	ps := r.Parameters
+	err := mapParameters(ctx, r.Parameters, model)
+	if err != nil {
+		return err
+	}
+
+	model.IsUpdatable = types.BoolPointerValue(r.IsUpdatable)
+	model.DashboardURL = conversion.ToOptionalString(r.DashboardUrl)
+	// Not all features are offered in every region and plan, so every field of the instance is optional
+	i := r.Instance
+	if i == nil {
+		i = &argus.InstanceSensitiveData{}
+	}
+	model.GrafanaURL = conversion.ToOptionalString(i.GrafanaUrl)
+	model.GrafanaPublicReadAccess = types.BoolPointerValue(i.GrafanaPublicReadAccess)
+	model.GrafanaInitialAdminPassword = types.StringPointerValue(i.GrafanaAdminPassword)
+	model.GrafanaInitialAdminUser = types.StringPointerValue(i.GrafanaAdminUser)
+	model.MetricsRetentionDays = conversion.ToTypeInt64(i.MetricsRetentionTimeRaw)
+	model.MetricsRetentionDays5mDownsampling = conversion.ToTypeInt64(i.MetricsRetentionTime5m)
+	model.MetricsRetentionDays1hDownsampling = conversion.ToTypeInt64(i.MetricsRetentionTime1h)
+	model.MetricsURL = conversion.ToOptionalString(i.MetricsUrl)
+	model.MetricsPushURL = conversion.ToOptionalString(i.PushMetricsUrl)
+	model.TargetsURL = conversion.ToOptionalString(i.TargetsUrl)
+	model.AlertingURL = conversion.ToOptionalString(i.AlertingUrl)
+	model.LogsURL = conversion.ToOptionalString(i.LogsUrl)
+	model.LogsPushURL = conversion.ToOptionalString(i.LogsPushUrl)
+	model.JaegerTracesURL = conversion.ToOptionalString(i.JaegerTracesUrl)
+	model.JaegerUIURL = conversion.ToOptionalString(i.JaegerUiUrl)
+	model.OtlpTracesURL = conversion.ToOptionalString(i.OtlpTracesUrl)
+	model.ZipkinSpansURL = conversion.ToOptionalString(i.ZipkinSpansUrl)
+	return nil
+}
+
+// mapParameters sets the effective parameters to all parameters returned by the API.
+// The parameters attribute only keeps the keys configured by the user, so that keys injected by the API don't cause a diff.
+func mapParameters(ctx context.Context, ps *map[string]string, model *Model) error {
 	if ps == nil {
 		model.Parameters = types.MapNull(types.StringType)
-	} else {
-		params := make(map[string]attr.Value, len(*ps))
-		for k, v := range *ps {
-			params[k] = types.StringValue(v)
-		}
-		res, diags := types.MapValueFrom(ctx, types.StringType, params)
-		if diags.HasError() {
-			return fmt.Errorf("parameter mapping %s", diags.Errors())
-		}
-		model.Parameters = res
+		model.EffectiveParameters = types.MapNull(types.StringType)
+		return nil
 	}
 
-	model.IsUpdatable = types.BoolPointerValue(r.IsUpdatable)
-	model.DashboardURL = types.StringPointerValue(r.DashboardUrl)
-	if r.Instance != nil {
-		i := *r.Instance
-		model.GrafanaURL = types.StringPointerValue(i.GrafanaUrl)
-		model.GrafanaPublicReadAccess = types.BoolPointerValue(i.GrafanaPublicReadAccess)
-		model.GrafanaInitialAdminPassword = types.StringPointerValue(i.GrafanaAdminPassword)
-		model.GrafanaInitialAdminUser = types.StringPointerValue(i.GrafanaAdminUser)
-		model.MetricsRetentionDays = types.Int64Value(int64(*i.MetricsRetentionTimeRaw))
-		model.MetricsRetentionDays5mDownsampling = types.Int64Value(int64(*i.MetricsRetentionTime5m))
-		model.MetricsRetentionDays1hDownsampling = types.Int64Value(int64(*i.MetricsRetentionTime1h))
-		model.MetricsURL = types.StringPointerValue(i.MetricsUrl)
-		model.MetricsPushURL = types.StringPointerValue(i.PushMetricsUrl)
-		model.TargetsURL = types.StringPointerValue(i.TargetsUrl)
-		model.AlertingURL = types.StringPointerValue(i.AlertingUrl)
-		model.LogsURL = types.StringPointerValue(i.LogsUrl)
-		model.LogsPushURL = types.StringPointerValue(i.LogsPushUrl)
-		model.JaegerTracesURL = types.StringPointerValue(i.JaegerTracesUrl)
-		model.JaegerUIURL = types.StringPointerValue(i.JaegerUiUrl)
-		model.OtlpTracesURL = types.StringPointerValue(i.OtlpTracesUrl)
-		model.ZipkinSpansURL = types.StringPointerValue(i.ZipkinSpansUrl)
+	effective := make(map[string]attr.Value, len(*ps))
+	for k, v := range *ps {
+		effective[k] = types.StringValue(v)
+	}
+	res, diags := types.MapValueFrom(ctx, types.StringType, effective)
+	if diags.HasError() {
+		return fmt.Errorf("parameter mapping %s", diags.Errors())
+	}
+	model.EffectiveParameters = res
+
+	if model.Parameters.IsNull() || model.Parameters.IsUnknown() {
+		model.Parameters = types.MapNull(types.StringType)
+		return nil
+	}
+	configured := make(map[string]attr.Value, len(model.Parameters.Elements()))
+	for k := range model.Parameters.Elements() {
+		if v, ok := effective[k]; ok {
+			configured[k] = v
+		}
+	}
+	res, diags = types.MapValueFrom(ctx, types.StringType, configured)
+	if diags.HasError() {
+		return fmt.Errorf("parameter mapping %s", diags.Errors())
 	}
+	model.Parameters = res
 	return nil
 }
 
@@ -2,12 +2,19 @@ package argus
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/argus"
 )
@@ -25,13 +32,14 @@ func TestMapFields(t *testing.T) {
 				Id: utils.Ptr("iid"),
 			},
 			Model{
-				Id:         types.StringValue("pid,iid"),
-				ProjectId:  types.StringValue("pid"),
-				InstanceId: types.StringValue("iid"),
-				PlanId:     types.StringNull(),
-				PlanName:   types.StringNull(),
-				Name:       types.StringNull(),
-				Parameters: types.MapNull(types.StringType),
+				Id:                  types.StringValue("pid,iid"),
+				ProjectId:           types.StringValue("pid"),
+				InstanceId:          types.StringValue("iid"),
+				PlanId:              types.StringNull(),
+				PlanName:            types.StringNull(),
+				Name:                types.StringNull(),
+				Parameters:          types.MapNull(types.StringType),
+				EffectiveParameters: types.MapNull(types.StringType),
 			},
 			true,
 		},
@@ -45,13 +53,14 @@ func TestMapFields(t *testing.T) {
 				Parameters: &map[string]string{"key": "value"},
 			},
 			Model{
-				Id:         types.StringValue("pid,iid"),
-				ProjectId:  types.StringValue("pid"),
-				Name:       types.StringValue("name"),
-				InstanceId: types.StringValue("iid"),
-				PlanId:     types.StringValue("planId"),
-				PlanName:   types.StringValue("plan1"),
-				Parameters: toTerraformStringMapMust(context.Background(), map[string]string{"key": "value"}),
+				Id:                  types.StringValue("pid,iid"),
+				ProjectId:           types.StringValue("pid"),
+				Name:                types.StringValue("name"),
+				InstanceId:          types.StringValue("iid"),
+				PlanId:              types.StringValue("planId"),
+				PlanName:            types.StringValue("plan1"),
+				Parameters:          types.MapNull(types.StringType),
+				EffectiveParameters: toTerraformStringMapMust(context.Background(), map[string]string{"key": "value"}),
 			},
 			true,
 		},
@@ -62,13 +71,47 @@ func TestMapFields(t *testing.T) {
 				Name: nil,
 			},
 			Model{
-				Id:         types.StringValue("pid,iid"),
-				ProjectId:  types.StringValue("pid"),
-				InstanceId: types.StringValue("iid"),
-				PlanId:     types.StringNull(),
-				PlanName:   types.StringNull(),
-				Name:       types.StringNull(),
-				Parameters: types.MapNull(types.StringType),
+				Id:                  types.StringValue("pid,iid"),
+				ProjectId:           types.StringValue("pid"),
+				InstanceId:          types.StringValue("iid"),
+				PlanId:              types.StringNull(),
+				PlanName:            types.StringNull(),
+				Name:                types.StringNull(),
+				Parameters:          types.MapNull(types.StringType),
+				EffectiveParameters: types.MapNull(types.StringType),
+			},
+			true,
+		},
+		{
+			"partially_featured_instance",
+			&argus.InstanceResponse{
+				Id: utils.Ptr("iid"),
+				Instance: &argus.InstanceSensitiveData{
+					GrafanaUrl:              utils.Ptr("https://grafana"),
+					MetricsUrl:              utils.Ptr("https://metrics"),
+					MetricsRetentionTimeRaw: utils.Ptr(int32(90)),
+					JaegerTracesUrl:         utils.Ptr(""),
+					JaegerUiUrl:             nil,
+					OtlpTracesUrl:           utils.Ptr(""),
+				},
+			},
+			Model{
+				Id:                                 types.StringValue("pid,iid"),
+				ProjectId:                          types.StringValue("pid"),
+				InstanceId:                         types.StringValue("iid"),
+				PlanId:                             types.StringNull(),
+				PlanName:                           types.StringNull(),
+				Name:                               types.StringNull(),
+				Parameters:                         types.MapNull(types.StringType),
+				EffectiveParameters:                types.MapNull(types.StringType),
+				GrafanaURL:                         types.StringValue("https://grafana"),
+				MetricsURL:                         types.StringValue("https://metrics"),
+				MetricsRetentionDays:               types.Int64Value(90),
+				MetricsRetentionDays5mDownsampling: types.Int64Null(),
+				MetricsRetentionDays1hDownsampling: types.Int64Null(),
+				JaegerTracesURL:                    types.StringNull(),
+				JaegerUIURL:                        types.StringNull(),
+				OtlpTracesURL:                      types.StringNull(),
 			},
 			true,
 		},
@@ -87,8 +130,11 @@ func TestMapFields(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
+			// Computed attributes are unknown on create, unavailable features have to be mapped to null
 			state := &Model{
-				ProjectId: tt.expected.ProjectId,
+				ProjectId:       tt.expected.ProjectId,
+				JaegerTracesURL: types.StringUnknown(),
+				OtlpTracesURL:   types.StringUnknown(),
 			}
 			err := mapFields(context.Background(), tt.input, state)
 			if !tt.isValid && err == nil {
@@ -107,6 +153,221 @@ func TestMapFields(t *testing.T) {
 	}
 }
 
+func TestMapParameters(t *testing.T) {
+	tests := []struct {
+		description        string
+		configured         basetypes.MapValue
+		input              *map[string]string
+		expectedParameters basetypes.MapValue
+		expectedEffective  basetypes.MapValue
+	}{
+		{
+			"nil_parameters",
+			makeTestMap(t),
+			nil,
+			types.MapNull(types.StringType),
+			types.MapNull(types.StringType),
+		},
+		{
+			"none_configured",
+			types.MapNull(types.StringType),
+			&map[string]string{"key": "value", "injected": "x"},
+			types.MapNull(types.StringType),
+			toTerraformStringMapMust(context.Background(), map[string]string{"key": "value", "injected": "x"}),
+		},
+		{
+			"ignore_injected_keys",
+			makeTestMap(t),
+			&map[string]string{"key": "other", "injected": "x"},
+			toTerraformStringMapMust(context.Background(), map[string]string{"key": "other"}),
+			toTerraformStringMapMust(context.Background(), map[string]string{"key": "other", "injected": "x"}),
+		},
+		{
+			"configured_key_removed",
+			makeTestMap(t),
+			&map[string]string{"injected": "x"},
+			toTerraformStringMapMust(context.Background(), map[string]string{}),
+			toTerraformStringMapMust(context.Background(), map[string]string{"injected": "x"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				Parameters: tt.configured,
+			}
+			err := mapParameters(context.Background(), tt.input, model)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(model.Parameters, tt.expectedParameters)
+			if diff != "" {
+				t.Fatalf("Parameters do not match: %s", diff)
+			}
+			diff = cmp.Diff(model.EffectiveParameters, tt.expectedEffective)
+			if diff != "" {
+				t.Fatalf("Effective parameters do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpgradeStateV0(t *testing.T) {
+	tests := []struct {
+		description        string
+		parameters         interface{}
+		expectedParameters basetypes.MapValue
+		expectedEffective  basetypes.MapValue
+	}{
+		{
+			"parameters_moved",
+			map[string]interface{}{"key": "value", "injected": "x"},
+			types.MapNull(types.StringType),
+			toTerraformStringMapMust(context.Background(), map[string]string{"key": "value", "injected": "x"}),
+		},
+		{
+			"no_parameters",
+			nil,
+			types.MapNull(types.StringType),
+			types.MapNull(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := context.Background()
+			r := &instanceResource{}
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			// The state of version 0 has all current attributes except effective_parameters
+			stateV0 := map[string]interface{}{}
+			for name := range schemaResp.Schema.Attributes {
+				if name != "effective_parameters" {
+					stateV0[name] = nil
+				}
+			}
+			stateV0["id"] = "pid,iid"
+			stateV0["parameters"] = tt.parameters
+			rawState, err := json.Marshal(stateV0)
+			if err != nil {
+				t.Fatalf("Encoding the state: %v", err)
+			}
+
+			resp := &resource.UpgradeStateResponse{}
+			upgradeStateV0(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: rawState}}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			raw, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+			if err != nil {
+				t.Fatalf("Decoding the upgraded state: %v", err)
+			}
+			var model Model
+			diags := tfsdk.State{Raw: raw, Schema: schemaResp.Schema}.Get(ctx, &model)
+			if diags.HasError() {
+				t.Fatalf("Reading the upgraded state: %v", diags.Errors())
+			}
+			if model.Id.ValueString() != "pid,iid" {
+				t.Fatalf("Id not kept: %s", model.Id)
+			}
+			diff := cmp.Diff(model.Parameters, tt.expectedParameters)
+			if diff != "" {
+				t.Fatalf("Parameters do not match: %s", diff)
+			}
+			diff = cmp.Diff(model.EffectiveParameters, tt.expectedEffective)
+			if diff != "" {
+				t.Fatalf("Effective parameters do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestModifyPlan(t *testing.T) {
+	tests := []struct {
+		description       string
+		stateParameters   map[string]string
+		planParameters    map[string]string
+		expectedEffective basetypes.MapValue
+	}{
+		{
+			"parameters_unchanged",
+			map[string]string{"key": "value"},
+			map[string]string{"key": "value"},
+			toTerraformStringMapMust(context.Background(), map[string]string{"key": "value", "injected": "x"}),
+		},
+		{
+			"parameters_changed",
+			map[string]string{"key": "value"},
+			map[string]string{"key": "other"},
+			types.MapUnknown(types.StringType),
+		},
+		{
+			"parameters_removed",
+			map[string]string{"key": "value"},
+			nil,
+			types.MapUnknown(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := context.Background()
+			r := &instanceResource{}
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			effective := map[string]string{"key": "value", "injected": "x"}
+
+			state := makeTestState(t, schemaResp.Schema, tt.stateParameters, effective)
+			plan := makeTestState(t, schemaResp.Schema, tt.planParameters, effective)
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Raw: state, Schema: schemaResp.Schema},
+				Plan:  tfsdk.Plan{Raw: plan, Schema: schemaResp.Schema},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Raw: plan.Copy(), Schema: schemaResp.Schema},
+			}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+
+			var effectiveParameters types.Map
+			diags := resp.Plan.GetAttribute(ctx, path.Root("effective_parameters"), &effectiveParameters)
+			if diags.HasError() {
+				t.Fatalf("Reading the plan: %v", diags.Errors())
+			}
+			diff := cmp.Diff(effectiveParameters, tt.expectedEffective)
+			if diff != "" {
+				t.Fatalf("Effective parameters do not match: %s", diff)
+			}
+		})
+	}
+}
+
+// makeTestState returns a state of the resource where all attributes but the parameters are null
+func makeTestState(t *testing.T, s schema.Schema, parameters, effectiveParameters map[string]string) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	toValue := func(m map[string]string) tftypes.Value {
+		mapType := tftypes.Map{ElementType: tftypes.String}
+		if m == nil {
+			return tftypes.NewValue(mapType, nil)
+		}
+		elements := map[string]tftypes.Value{}
+		for k, v := range m {
+			elements[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(mapType, elements)
+	}
+
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["parameters"] = toValue(parameters)
+	values["effective_parameters"] = toValue(effectiveParameters)
+	return tftypes.NewValue(objectType, values)
+}
+
 func TestToCreatePayload(t *testing.T) {
 	tests := []struct {
 		description string
@@ -0,0 +1,196 @@
+package argus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/argus"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &instancesDataSource{}
+)
+
+type Model struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	ProjectId  types.String `tfsdk:"project_id"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Instances  []Instance   `tfsdk:"instances"`
+}
+
+type Instance struct {
+	InstanceId types.String `tfsdk:"instance_id"`
+	Name       types.String `tfsdk:"name"`
+	PlanName   types.String `tfsdk:"plan_name"`
+	Status     types.String `tfsdk:"status"`
+}
+
+// NewInstancesDataSource is a helper function to simplify the provider implementation.
+func NewInstancesDataSource() datasource.DataSource {
+	return &instancesDataSource{}
+}
+
+// instancesDataSource is the data source implementation.
+type instancesDataSource struct {
+	client *argus.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *instancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_argus_instances"
+}
+
+func (d *instancesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var apiClient *argus.APIClient
+	var err error
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	if providerData.ArgusCustomEndpoint != "" {
+		apiClient, err = argus.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
+			config.WithEndpoint(providerData.ArgusCustomEndpoint),
+		)
+	} else {
+		apiClient, err = argus.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
+			config.WithRegion(providerData.Region),
+		)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not Configure API Client",
+			err.Error(),
+		)
+		return
+	}
+	d.client = apiClient
+}
+
+// Schema defines the schema for the data source.
+func (d *instancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Argus instances data source schema. Lists all Argus instances of a project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the instances are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "If set, only instances whose name starts with this prefix are returned.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"instances": schema.ListNestedAttribute{
+				Description: "The Argus instances of the project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Description: "The Argus instance ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the Argus instance.",
+							Computed:    true,
+						},
+						"plan_name": schema.StringAttribute{
+							Description: "The Argus plan of the instance.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the Argus instance.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *instancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state Model
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	projectId := state.ProjectId.ValueString()
+	instancesResponse, err := d.client.GetInstances(ctx, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to list instances", fmt.Sprintf("Project id = %s: %v", projectId, err))
+		return
+	}
+
+	err = mapFields(instancesResponse, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func mapFields(r *argus.ProjectInstanceFullMany, model *Model) error {
+	if r == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	namePrefix := model.NamePrefix.ValueString()
+	instances := []Instance{}
+	if r.Instances != nil {
+		for _, i := range *r.Instances {
+			if i.Id == nil {
+				return fmt.Errorf("instance id not present")
+			}
+			if namePrefix != "" && (i.Name == nil || !strings.HasPrefix(*i.Name, namePrefix)) {
+				continue
+			}
+			instances = append(instances, Instance{
+				InstanceId: types.StringPointerValue(i.Id),
+				Name:       types.StringPointerValue(i.Name),
+				PlanName:   types.StringPointerValue(i.PlanName),
+				Status:     types.StringPointerValue(i.Status),
+			})
+		}
+	}
+	model.Instances = instances
+	return nil
+}
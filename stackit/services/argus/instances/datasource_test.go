@@ -0,0 +1,144 @@
+package argus
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/argus"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		namePrefix  types.String
+		input       *argus.ProjectInstanceFullMany
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			types.StringNull(),
+			&argus.ProjectInstanceFullMany{},
+			Model{
+				Id:         types.StringValue("pid"),
+				ProjectId:  types.StringValue("pid"),
+				NamePrefix: types.StringNull(),
+				Instances:  []Instance{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			types.StringNull(),
+			&argus.ProjectInstanceFullMany{
+				Instances: &[]argus.ProjectInstanceFull{
+					{
+						Id:       utils.Ptr("iid-1"),
+						Name:     utils.Ptr("name-1"),
+						PlanName: utils.Ptr("plan"),
+						Status:   utils.Ptr("CREATE_SUCCEEDED"),
+					},
+					{
+						Id: utils.Ptr("iid-2"),
+					},
+				},
+			},
+			Model{
+				Id:         types.StringValue("pid"),
+				ProjectId:  types.StringValue("pid"),
+				NamePrefix: types.StringNull(),
+				Instances: []Instance{
+					{
+						InstanceId: types.StringValue("iid-1"),
+						Name:       types.StringValue("name-1"),
+						PlanName:   types.StringValue("plan"),
+						Status:     types.StringValue("CREATE_SUCCEEDED"),
+					},
+					{
+						InstanceId: types.StringValue("iid-2"),
+						Name:       types.StringNull(),
+						PlanName:   types.StringNull(),
+						Status:     types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"name_prefix",
+			types.StringValue("prod-"),
+			&argus.ProjectInstanceFullMany{
+				Instances: &[]argus.ProjectInstanceFull{
+					{
+						Id:   utils.Ptr("iid-1"),
+						Name: utils.Ptr("prod-monitoring"),
+					},
+					{
+						Id:   utils.Ptr("iid-2"),
+						Name: utils.Ptr("dev-monitoring"),
+					},
+					{
+						Id: utils.Ptr("iid-3"),
+					},
+				},
+			},
+			Model{
+				Id:         types.StringValue("pid"),
+				ProjectId:  types.StringValue("pid"),
+				NamePrefix: types.StringValue("prod-"),
+				Instances: []Instance{
+					{
+						InstanceId: types.StringValue("iid-1"),
+						Name:       types.StringValue("prod-monitoring"),
+						PlanName:   types.StringNull(),
+						Status:     types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			types.StringNull(),
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"no_instance_id",
+			types.StringNull(),
+			&argus.ProjectInstanceFullMany{
+				Instances: &[]argus.ProjectInstanceFull{
+					{
+						Name: utils.Ptr("name"),
+					},
+				},
+			},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId:  types.StringValue("pid"),
+				NamePrefix: tt.namePrefix,
+			}
+			err := mapFields(tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/argus"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 )
 
@@ -54,12 +55,12 @@ func (d *scrapeConfigDataSource) Configure(_ context.Context, req datasource.Con
 
 	if providerData.ArgusCustomEndpoint != "" {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithEndpoint(providerData.ArgusCustomEndpoint),
 		)
 	} else {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -85,7 +86,7 @@ func (d *scrapeConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: "STACKIT project ID to which the scraping job is associated.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -93,7 +94,7 @@ func (d *scrapeConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: "Argus instance ID to which the scraping job is associated.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -148,14 +149,7 @@ func (d *scrapeConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							stringvalidator.LengthBetween(1, 200),
 						},
 					},
-					"password": schema.StringAttribute{
-						Description: "Specifies basic auth password.",
-						Computed:    true,
-						Sensitive:   true,
-						Validators: []validator.String{
-							stringvalidator.LengthBetween(1, 200),
-						},
-					},
+					"password": schemas.DataSourceSensitiveComputedString("Specifies basic auth password."),
 				},
 			},
 			"targets": schema.ListNestedAttribute{
@@ -186,6 +180,43 @@ func (d *scrapeConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 					},
 				},
 			},
+			"metrics_relabel_configs": schema.ListNestedAttribute{
+				Description: "Relabeling rules applied to the scraped samples before they are ingested.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_labels": schema.ListAttribute{
+							Description: "The labels whose values are concatenated with `separator` and matched against `regex`.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"action": schema.StringAttribute{
+							Description: "The action to perform based on the regex match.",
+							Computed:    true,
+						},
+						"regex": schema.StringAttribute{
+							Description: "The regular expression matched against the concatenated source label values.",
+							Computed:    true,
+						},
+						"replacement": schema.StringAttribute{
+							Description: "The value written to `target_label` by the `replace` action.",
+							Computed:    true,
+						},
+						"separator": schema.StringAttribute{
+							Description: "The separator placed between the concatenated source label values.",
+							Computed:    true,
+						},
+						"target_label": schema.StringAttribute{
+							Description: "The label to which the result is written.",
+							Computed:    true,
+						},
+						"modulus": schema.Int64Attribute{
+							Description: "The modulus taken of the hash of the source label values.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -198,6 +229,9 @@ func (d *scrapeConfigDataSource) Read(ctx context.Context, req datasource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	model.ProjectId = core.CanonicalizeIDValue(model.ProjectId)
+	model.InstanceId = core.CanonicalizeIDValue(model.InstanceId)
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
 	scName := model.Name.ValueString()
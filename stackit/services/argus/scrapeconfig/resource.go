@@ -6,10 +6,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,6 +27,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/argus"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 )
 
@@ -33,13 +36,22 @@ const (
 	DefaultScrapeInterval           = "5m"
 	DefaultScrapeTimeout            = "2m"
 	DefaultSAML2EnableURLParameters = true
+	DefaultRelabelAction            = "replace"
+	DefaultRelabelRegex             = "(.*)"
+	DefaultRelabelReplacement       = "$1"
+	DefaultRelabelSeparator         = ";"
 )
 
+// relabelActions are the actions supported in metrics relabel configs.
+var relabelActions = []string{"replace", "keep", "drop", "hashmod", "labelmap", "labeldrop", "labelkeep"}
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &scrapeConfigResource{}
-	_ resource.ResourceWithConfigure   = &scrapeConfigResource{}
-	_ resource.ResourceWithImportState = &scrapeConfigResource{}
+	_ resource.Resource                   = &scrapeConfigResource{}
+	_ resource.ResourceWithConfigure      = &scrapeConfigResource{}
+	_ resource.ResourceWithImportState    = &scrapeConfigResource{}
+	_ resource.ResourceWithModifyPlan     = &scrapeConfigResource{}
+	_ resource.ResourceWithValidateConfig = &scrapeConfigResource{}
 )
 
 type Model struct {
@@ -54,6 +66,8 @@ type Model struct {
 	SAML2          *SAML2       `tfsdk:"saml2"`
 	BasicAuth      *BasicAuth   `tfsdk:"basic_auth"`
 	Targets        []Target     `tfsdk:"targets"`
+
+	MetricsRelabelConfigs []MetricsRelabelConfig `tfsdk:"metrics_relabel_configs"`
 }
 
 type SAML2 struct {
@@ -65,6 +79,16 @@ type Target struct {
 	Labels types.Map      `tfsdk:"labels"`
 }
 
+type MetricsRelabelConfig struct {
+	SourceLabels []types.String `tfsdk:"source_labels"`
+	Action       types.String   `tfsdk:"action"`
+	Regex        types.String   `tfsdk:"regex"`
+	Replacement  types.String   `tfsdk:"replacement"`
+	Separator    types.String   `tfsdk:"separator"`
+	TargetLabel  types.String   `tfsdk:"target_label"`
+	Modulus      types.Int64    `tfsdk:"modulus"`
+}
+
 type BasicAuth struct {
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
@@ -77,7 +101,8 @@ func NewScrapeConfigResource() resource.Resource {
 
 // scrapeConfigResource is the resource implementation.
 type scrapeConfigResource struct {
-	client *argus.APIClient
+	client          *argus.APIClient
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -102,12 +127,12 @@ func (r *scrapeConfigResource) Configure(_ context.Context, req resource.Configu
 	var err error
 	if providerData.ArgusCustomEndpoint != "" {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithEndpoint(providerData.ArgusCustomEndpoint),
 		)
 	} else {
 		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -117,6 +142,7 @@ func (r *scrapeConfigResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
 }
 
 // Schema defines the schema for the resource.
@@ -218,18 +244,14 @@ func (r *scrapeConfigResource) Schema(_ context.Context, _ resource.SchemaReques
 							stringvalidator.LengthBetween(1, 200),
 						},
 					},
-					"password": schema.StringAttribute{
-						Description: "Specifies basic auth password.",
-						Required:    true,
-						Sensitive:   true,
-						Validators: []validator.String{
-							stringvalidator.LengthBetween(1, 200),
-						},
-					},
+					"password": schemas.SensitiveRequiredString(
+						"Specifies basic auth password.",
+						stringvalidator.LengthBetween(1, 200),
+					),
 				},
 			},
 			"targets": schema.ListNestedAttribute{
-				Description: "The targets list (specified by the static config).",
+				Description: "The targets list (specified by the static config). The total number of targets across all scrape configurations of the instance is validated against the limit of the Argus plan at plan time.",
 				Required:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -256,10 +278,256 @@ func (r *scrapeConfigResource) Schema(_ context.Context, _ resource.SchemaReques
 					},
 				},
 			},
+			"metrics_relabel_configs": schema.ListNestedAttribute{
+				Description: "Relabeling rules applied to the scraped samples before they are ingested, in the order they are listed. " +
+					"They can e.g. drop high-cardinality series to stay within the limits of the Argus plan. See the Prometheus `metric_relabel_configs` documentation for details.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_labels": schema.ListAttribute{
+							Description: "The labels whose values are concatenated with `separator` and matched against `regex`. E.g. `[\"__name__\"]`",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"action": schema.StringAttribute{
+							Description: fmt.Sprintf("The action to perform based on the regex match. Supported values: %s. Defaults to `%s`.", formatValues(relabelActions), DefaultRelabelAction),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(DefaultRelabelAction),
+							Validators: []validator.String{
+								stringvalidator.OneOf(relabelActions...),
+							},
+						},
+						"regex": schema.StringAttribute{
+							Description: fmt.Sprintf("The regular expression matched against the concatenated source label values. Defaults to `%s`.", DefaultRelabelRegex),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(DefaultRelabelRegex),
+						},
+						"replacement": schema.StringAttribute{
+							Description: fmt.Sprintf("The value written to `target_label` by the `replace` action. Regex capture groups are available. Defaults to `%s`.", DefaultRelabelReplacement),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(DefaultRelabelReplacement),
+						},
+						"separator": schema.StringAttribute{
+							Description: fmt.Sprintf("The separator placed between the concatenated source label values. Defaults to `%s`.", DefaultRelabelSeparator),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(DefaultRelabelSeparator),
+						},
+						"target_label": schema.StringAttribute{
+							Description: "The label to which the result is written. Required for the `replace` and `hashmod` actions.",
+							Optional:    true,
+						},
+						"modulus": schema.Int64Attribute{
+							Description: "The modulus taken of the hash of the source label values. Required for the `hashmod` action.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the resource configuration.
+func (r *scrapeConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The list and the attributes that aren't checked, e.g. source_labels, may be unknown at this point,
+	// so only the checked attributes are read from each relabel config
+	var relabelConfigsList types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metrics_relabel_configs"), &relabelConfigsList)...)
+	if resp.Diagnostics.HasError() || relabelConfigsList.IsNull() || relabelConfigsList.IsUnknown() {
+		return
+	}
+	relabelConfigs := make([]MetricsRelabelConfig, len(relabelConfigsList.Elements()))
+	for i, element := range relabelConfigsList.Elements() {
+		if element.IsUnknown() {
+			// Skipped by checkRelabelConfigs
+			relabelConfigs[i].Action = types.StringUnknown()
+			continue
+		}
+		configPath := path.Root("metrics_relabel_configs").AtListIndex(i)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, configPath.AtName("action"), &relabelConfigs[i].Action)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, configPath.AtName("target_label"), &relabelConfigs[i].TargetLabel)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, configPath.AtName("modulus"), &relabelConfigs[i].Modulus)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkRelabelConfigs(relabelConfigs)...)
+}
+
+// checkRelabelConfigs validates that the attributes required by the action of each relabel config are set.
+func checkRelabelConfigs(relabelConfigs []MetricsRelabelConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, rc := range relabelConfigs {
+		if rc.Action.IsUnknown() {
+			continue
+		}
+		action := rc.Action.ValueString()
+		if action == "" {
+			action = DefaultRelabelAction
+		}
+		configPath := path.Root("metrics_relabel_configs").AtListIndex(i)
+		if (action == "replace" || action == "hashmod") && rc.TargetLabel.IsNull() {
+			diags.AddAttributeError(configPath.AtName("target_label"), "Missing target label",
+				fmt.Sprintf("target_label is required for the %q action", action))
+		}
+		if action == "hashmod" && rc.Modulus.IsNull() {
+			diags.AddAttributeError(configPath.AtName("modulus"), "Missing modulus",
+				"modulus is required for the \"hashmod\" action")
+		}
+	}
+	return diags
+}
+
+// formatValues formats the values as a list of code spans for descriptions, e.g. "`a`, `b`".
+func formatValues(values []string) string {
+	formatted := make([]string, len(values))
+	for i, v := range values {
+		formatted[i] = fmt.Sprintf("`%s`", v)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// ModifyPlan validates the planned scrape targets against the limits of the Argus instance's plan,
+// so that exceeding them fails at plan time instead of mid-apply.
+// The validation is skipped if the instance or the targets are not known yet, or if the limits can't be fetched.
+func (r *scrapeConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// Nothing to validate on destroy or if the provider isn't configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var projectId, instanceId, scName types.String
+	var targets types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance_id"), &instanceId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &scName)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("targets"), &targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if projectId.IsUnknown() || instanceId.IsUnknown() || scName.IsUnknown() {
+		return
+	}
+	plannedTargets, known := countPlannedTargets(targets)
+	if !known {
+		return
+	}
+	ctx = tflog.SetField(ctx, "project_id", projectId.ValueString())
+	ctx = tflog.SetField(ctx, "instance_id", instanceId.ValueString())
+
+	plan, jobs, err := r.loadPlanLimits(ctx, projectId.ValueString(), instanceId.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping validation of scrape targets against the plan limits: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(checkTargetLimit(plan, jobs, scName.ValueString(), plannedTargets)...)
+}
+
+// loadPlanLimits fetches the plan of the Argus instance and its existing scrape configs.
+func (r *scrapeConfigResource) loadPlanLimits(ctx context.Context, projectId, instanceId string) (*argus.PlanModel, []argus.Job, error) {
+	instanceResp, err := r.client.GetInstance(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting instance: %w", err)
+	}
+	if instanceResp.PlanId == nil {
+		return nil, nil, fmt.Errorf("instance has no plan ID")
+	}
+	plansResp, err := r.client.GetPlans(ctx, projectId).Execute()
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting plans: %w", err)
+	}
+	var plan *argus.PlanModel
+	if plansResp.Plans != nil {
+		for i := range *plansResp.Plans {
+			p := &(*plansResp.Plans)[i]
+			if p.PlanId != nil && *p.PlanId == *instanceResp.PlanId {
+				plan = p
+				break
+			}
+		}
+	}
+	if plan == nil {
+		return nil, nil, fmt.Errorf("plan %s not found", *instanceResp.PlanId)
+	}
+	scrapeConfigsResp, err := r.client.GetScrapeConfigs(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting scrape configs: %w", err)
+	}
+	jobs := []argus.Job{}
+	if scrapeConfigsResp.Data != nil {
+		jobs = *scrapeConfigsResp.Data
+	}
+	return plan, jobs, nil
+}
+
+// countPlannedTargets returns the number of target URLs in the planned targets.
+// The second return value is false if the number is not known yet.
+func countPlannedTargets(targets types.List) (int, bool) {
+	if targets.IsUnknown() {
+		return 0, false
+	}
+	count := 0
+	for _, t := range targets.Elements() {
+		target, ok := t.(types.Object)
+		if !ok || target.IsUnknown() {
+			return 0, false
+		}
+		urls, ok := target.Attributes()["urls"].(types.List)
+		if !ok || urls.IsUnknown() {
+			return 0, false
+		}
+		count += len(urls.Elements())
+	}
+	return count, true
+}
+
+// checkTargetLimit checks that the targets of all scrape configs of the instance, with the scrape config scName
+// having plannedTargets targets, don't exceed the number of targets allowed by the plan.
+func checkTargetLimit(plan *argus.PlanModel, jobs []argus.Job, scName string, plannedTargets int) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan == nil || plan.TargetNumber == nil {
+		return diags
+	}
+	total := plannedTargets
+	for _, job := range jobs {
+		if job.JobName != nil && *job.JobName == scName {
+			continue
+		}
+		if job.StaticConfigs == nil {
+			continue
+		}
+		for _, sc := range *job.StaticConfigs {
+			if sc.Targets != nil {
+				total += len(*sc.Targets)
+			}
+		}
+	}
+	limit := int(*plan.TargetNumber)
+	if total > limit {
+		planName := "unknown"
+		if plan.Name != nil {
+			planName = *plan.Name
+		}
+		diags.AddAttributeError(
+			path.Root("targets"),
+			"Too many scrape targets for the Argus plan",
+			fmt.Sprintf("The plan %q allows %d targets per instance, but the instance would have %d targets (%d of them in scrape config %q). "+
+				"Remove targets or upgrade the plan of the Argus instance.", planName, limit, total, plannedTargets, scName),
+		)
+	}
+	return diags
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *scrapeConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
@@ -285,14 +553,14 @@ func (r *scrapeConfigResource) Create(ctx context.Context, req resource.CreateRe
 		resp.Diagnostics.AddError("Error creating scrape config", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = argus.CreateScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).SetTimeout(3 * time.Minute).WaitWithContext(ctx)
+	_, err = argus.CreateScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).SetTimeout(r.defaultTimeouts.CreateOr(3 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating scrape config", fmt.Sprintf("ScrapeConfig creation waiting: %v", err))
+		resp.Diagnostics.AddError("Error creating scrape config", fmt.Sprintf("ScrapeConfig creation waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, err := r.client.GetScrapeConfig(ctx, instanceId, scName, projectId).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating scrape config", fmt.Sprintf("ScrapeConfig creation waiting: %v", err))
+		resp.Diagnostics.AddError("Error creating scrape config", fmt.Sprintf("ScrapeConfig creation waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	err = mapFields(got.Data, &model)
@@ -320,6 +588,12 @@ func (r *scrapeConfigResource) Read(ctx context.Context, req resource.ReadReques
 
 	scResp, err := r.client.GetScrapeConfig(ctx, instanceId, scName, projectId).Execute()
 	if err != nil {
+		// The scrape config is also gone if the instance was deleted
+		if core.IsNotFound(err) {
+			tflog.Warn(ctx, "ARGUS scrape config not found, removing it from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading scrape config", fmt.Sprintf("Project id = %s, instance id = %s, scrape config name = %s: %v", projectId, instanceId, scName, err))
 		return
 	}
@@ -396,12 +670,16 @@ func (r *scrapeConfigResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Delete existing ScrapeConfig
 	_, err := r.client.DeleteScrapeConfig(ctx, instanceId, scName, projectId).Execute()
 	if err != nil {
+		if core.IsNotFound(err) {
+			tflog.Info(ctx, "ARGUS scrape config already deleted")
+			return
+		}
 		resp.Diagnostics.AddError("Error deleting scrape config", "project id = "+projectId+", instance id = "+instanceId+", scrape config name = "+scName+", "+err.Error())
 		return
 	}
-	_, err = argus.DeleteScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	_, err = argus.DeleteScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).SetTimeout(r.defaultTimeouts.DeleteOr(1 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting scrape config", fmt.Sprintf("ScrapeConfig deletion waiting: %v", err))
+		resp.Diagnostics.AddError("Error deleting scrape config", fmt.Sprintf("ScrapeConfig deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "ARGUS scrape config deleted")
@@ -410,7 +688,7 @@ func (r *scrapeConfigResource) Delete(ctx context.Context, req resource.DeleteRe
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id,name
 func (r *scrapeConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		resp.Diagnostics.AddError(
@@ -449,7 +727,7 @@ func mapFields(sc *argus.Job, model *Model) error {
 		scName,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.Name = types.StringValue(scName)
 
@@ -460,9 +738,72 @@ func mapFields(sc *argus.Job, model *Model) error {
 	handleSAML2(sc, model)
 	handleBasicAuth(sc, model)
 	handleTargets(sc, model)
+	handleMetricsRelabelConfigs(sc, model)
 	return nil
 }
 
+func handleMetricsRelabelConfigs(sc *argus.Job, model *Model) {
+	if sc.MetricsRelabelConfigs == nil || len(*sc.MetricsRelabelConfigs) == 0 {
+		model.MetricsRelabelConfigs = nil
+		return
+	}
+	relabelConfigs := []MetricsRelabelConfig{}
+	for _, rc := range *sc.MetricsRelabelConfigs {
+		sourceLabels := []types.String{}
+		if rc.SourceLabels != nil {
+			for _, l := range *rc.SourceLabels {
+				sourceLabels = append(sourceLabels, types.StringValue(l))
+			}
+		}
+		modulus := types.Int64Null()
+		if rc.Modulus != nil {
+			modulus = types.Int64Value(int64(*rc.Modulus))
+		}
+		relabelConfigs = append(relabelConfigs, MetricsRelabelConfig{
+			SourceLabels: sourceLabels,
+			Action:       stringValueOrDefault(rc.Action, DefaultRelabelAction),
+			Regex:        stringValueOrDefault(rc.Regex, DefaultRelabelRegex),
+			Replacement:  stringValueOrDefault(rc.Replacement, DefaultRelabelReplacement),
+			Separator:    stringValueOrDefault(rc.Separator, DefaultRelabelSeparator),
+			TargetLabel:  types.StringPointerValue(rc.TargetLabel),
+			Modulus:      modulus,
+		})
+	}
+	model.MetricsRelabelConfigs = relabelConfigs
+}
+
+// stringValueOrDefault returns the default value if the API omits a value, as the API doesn't return the defaults it applies.
+func stringValueOrDefault(value *string, defaultValue string) types.String {
+	if value == nil {
+		return types.StringValue(defaultValue)
+	}
+	return types.StringValue(*value)
+}
+
+func toMetricsRelabelConfigsPayload(relabelConfigs []MetricsRelabelConfig) *[]argus.UpdateScrapeConfigPayloadMetricsRelabelConfigsInner {
+	payload := []argus.UpdateScrapeConfigPayloadMetricsRelabelConfigsInner{}
+	for _, rc := range relabelConfigs {
+		sourceLabels := []string{}
+		for _, l := range rc.SourceLabels {
+			sourceLabels = append(sourceLabels, l.ValueString())
+		}
+		var modulus *float32
+		if !rc.Modulus.IsNull() && !rc.Modulus.IsUnknown() {
+			modulus = utils.Ptr(float32(rc.Modulus.ValueInt64()))
+		}
+		payload = append(payload, argus.UpdateScrapeConfigPayloadMetricsRelabelConfigsInner{
+			SourceLabels: &sourceLabels,
+			Action:       rc.Action.ValueStringPointer(),
+			Regex:        rc.Regex.ValueStringPointer(),
+			Replacement:  rc.Replacement.ValueStringPointer(),
+			Separator:    rc.Separator.ValueStringPointer(),
+			TargetLabel:  rc.TargetLabel.ValueStringPointer(),
+			Modulus:      modulus,
+		})
+	}
+	return &payload
+}
+
 func handleBasicAuth(sc *argus.Job, model *Model) {
 	if sc.BasicAuth == nil {
 		model.BasicAuth = nil
@@ -578,6 +919,7 @@ func toCreatePayload(ctx context.Context, model *Model) (*argus.CreateScrapeConf
 		t[i] = ti
 	}
 	sc.StaticConfigs = &t
+	sc.MetricsRelabelConfigs = toMetricsRelabelConfigsPayload(model.MetricsRelabelConfigs)
 	return &sc, nil
 }
 
@@ -657,6 +999,7 @@ func toUpdatePayload(ctx context.Context, model *Model) (*argus.UpdateScrapeConf
 		t[i] = ti
 	}
 	sc.StaticConfigs = &t
+	sc.MetricsRelabelConfigs = toMetricsRelabelConfigsPayload(model.MetricsRelabelConfigs)
 	return &sc, nil
 }
 
@@ -6,7 +6,10 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/argus"
 )
@@ -104,6 +107,58 @@ func TestMapFields(t *testing.T) {
 			},
 			isValid: true,
 		},
+		{
+			description: "metrics_relabel_configs_ok",
+			input: &argus.Job{
+				JobName: utils.Ptr("name"),
+				MetricsRelabelConfigs: &[]argus.MetricsRelabelConfig{
+					{
+						SourceLabels: &[]string{"__name__"},
+						Action:       utils.Ptr("drop"),
+						Regex:        utils.Ptr("go_.*"),
+					},
+					{
+						SourceLabels: &[]string{"instance", "job"},
+						Action:       utils.Ptr("hashmod"),
+						Separator:    utils.Ptr("-"),
+						TargetLabel:  utils.Ptr("shard"),
+						Modulus:      utils.Ptr(int32(4)),
+					},
+				},
+			},
+			expected: Model{
+				Id:             types.StringValue("pid,iid,name"),
+				ProjectId:      types.StringValue("pid"),
+				InstanceId:     types.StringValue("iid"),
+				Name:           types.StringValue("name"),
+				MetricsPath:    types.StringNull(),
+				Scheme:         types.StringNull(),
+				ScrapeInterval: types.StringNull(),
+				ScrapeTimeout:  types.StringNull(),
+				Targets:        []Target{},
+				MetricsRelabelConfigs: []MetricsRelabelConfig{
+					{
+						SourceLabels: []types.String{types.StringValue("__name__")},
+						Action:       types.StringValue("drop"),
+						Regex:        types.StringValue("go_.*"),
+						Replacement:  types.StringValue("$1"),
+						Separator:    types.StringValue(";"),
+						TargetLabel:  types.StringNull(),
+						Modulus:      types.Int64Null(),
+					},
+					{
+						SourceLabels: []types.String{types.StringValue("instance"), types.StringValue("job")},
+						Action:       types.StringValue("hashmod"),
+						Regex:        types.StringValue("(.*)"),
+						Replacement:  types.StringValue("$1"),
+						Separator:    types.StringValue("-"),
+						TargetLabel:  types.StringValue("shard"),
+						Modulus:      types.Int64Value(4),
+					},
+				},
+			},
+			isValid: true,
+		},
 		{
 			"response_nil_fail",
 			nil,
@@ -155,11 +210,12 @@ func TestToCreatePayload(t *testing.T) {
 			&argus.CreateScrapeConfigPayload{
 				MetricsPath: utils.Ptr("/metrics"),
 				// Defaults
-				Scheme:         utils.Ptr("https"),
-				ScrapeInterval: utils.Ptr("5m"),
-				ScrapeTimeout:  utils.Ptr("2m"),
-				StaticConfigs:  &[]argus.CreateScrapeConfigPayloadStaticConfigsInner{},
-				Params:         &map[string]any{"saml2": []string{"enabled"}},
+				Scheme:                utils.Ptr("https"),
+				ScrapeInterval:        utils.Ptr("5m"),
+				ScrapeTimeout:         utils.Ptr("2m"),
+				StaticConfigs:         &[]argus.CreateScrapeConfigPayloadStaticConfigsInner{},
+				Params:                &map[string]any{"saml2": []string{"enabled"}},
+				MetricsRelabelConfigs: &[]argus.UpdateScrapeConfigPayloadMetricsRelabelConfigsInner{},
 			},
 			true,
 		},
@@ -173,6 +229,45 @@ func TestToCreatePayload(t *testing.T) {
 				MetricsPath: utils.Ptr("/metrics"),
 				JobName:     utils.Ptr("Name"),
 				// Defaults
+				Scheme:                utils.Ptr("https"),
+				ScrapeInterval:        utils.Ptr("5m"),
+				ScrapeTimeout:         utils.Ptr("2m"),
+				StaticConfigs:         &[]argus.CreateScrapeConfigPayloadStaticConfigsInner{},
+				Params:                &map[string]any{"saml2": []string{"enabled"}},
+				MetricsRelabelConfigs: &[]argus.UpdateScrapeConfigPayloadMetricsRelabelConfigsInner{},
+			},
+			true,
+		},
+		{
+			"metrics_relabel_configs_ok",
+			&Model{
+				MetricsPath: types.StringValue("/metrics"),
+				MetricsRelabelConfigs: []MetricsRelabelConfig{
+					{
+						SourceLabels: []types.String{types.StringValue("instance"), types.StringValue("job")},
+						Action:       types.StringValue("hashmod"),
+						Regex:        types.StringValue("(.*)"),
+						Replacement:  types.StringValue("$1"),
+						Separator:    types.StringValue(";"),
+						TargetLabel:  types.StringValue("shard"),
+						Modulus:      types.Int64Value(4),
+					},
+				},
+			},
+			&argus.CreateScrapeConfigPayload{
+				MetricsPath: utils.Ptr("/metrics"),
+				MetricsRelabelConfigs: &[]argus.UpdateScrapeConfigPayloadMetricsRelabelConfigsInner{
+					{
+						SourceLabels: &[]string{"instance", "job"},
+						Action:       utils.Ptr("hashmod"),
+						Regex:        utils.Ptr("(.*)"),
+						Replacement:  utils.Ptr("$1"),
+						Separator:    utils.Ptr(";"),
+						TargetLabel:  utils.Ptr("shard"),
+						Modulus:      utils.Ptr(float32(4)),
+					},
+				},
+				// Defaults
 				Scheme:         utils.Ptr("https"),
 				ScrapeInterval: utils.Ptr("5m"),
 				ScrapeTimeout:  utils.Ptr("2m"),
@@ -222,10 +317,11 @@ func TestToUpdatePayload(t *testing.T) {
 			&argus.UpdateScrapeConfigPayload{
 				MetricsPath: utils.Ptr("/metrics"),
 				// Defaults
-				Scheme:         utils.Ptr("https"),
-				ScrapeInterval: utils.Ptr("5m"),
-				ScrapeTimeout:  utils.Ptr("2m"),
-				StaticConfigs:  &[]argus.UpdateScrapeConfigPayloadStaticConfigsInner{},
+				Scheme:                utils.Ptr("https"),
+				ScrapeInterval:        utils.Ptr("5m"),
+				ScrapeTimeout:         utils.Ptr("2m"),
+				StaticConfigs:         &[]argus.UpdateScrapeConfigPayloadStaticConfigsInner{},
+				MetricsRelabelConfigs: &[]argus.UpdateScrapeConfigPayloadMetricsRelabelConfigsInner{},
 			},
 			true,
 		},
@@ -238,7 +334,43 @@ func TestToUpdatePayload(t *testing.T) {
 			&argus.UpdateScrapeConfigPayload{
 				MetricsPath: utils.Ptr("/metrics"),
 				// Defaults
-				Scheme:         utils.Ptr("http"),
+				Scheme:                utils.Ptr("http"),
+				ScrapeInterval:        utils.Ptr("5m"),
+				ScrapeTimeout:         utils.Ptr("2m"),
+				StaticConfigs:         &[]argus.UpdateScrapeConfigPayloadStaticConfigsInner{},
+				MetricsRelabelConfigs: &[]argus.UpdateScrapeConfigPayloadMetricsRelabelConfigsInner{},
+			},
+			true,
+		},
+		{
+			"metrics_relabel_configs_ok",
+			&Model{
+				MetricsPath: types.StringValue("/metrics"),
+				MetricsRelabelConfigs: []MetricsRelabelConfig{
+					{
+						SourceLabels: []types.String{types.StringValue("__name__")},
+						Action:       types.StringValue("drop"),
+						Regex:        types.StringValue("go_.*"),
+						Replacement:  types.StringValue("$1"),
+						Separator:    types.StringValue(";"),
+						TargetLabel:  types.StringNull(),
+						Modulus:      types.Int64Null(),
+					},
+				},
+			},
+			&argus.UpdateScrapeConfigPayload{
+				MetricsPath: utils.Ptr("/metrics"),
+				MetricsRelabelConfigs: &[]argus.UpdateScrapeConfigPayloadMetricsRelabelConfigsInner{
+					{
+						SourceLabels: &[]string{"__name__"},
+						Action:       utils.Ptr("drop"),
+						Regex:        utils.Ptr("go_.*"),
+						Replacement:  utils.Ptr("$1"),
+						Separator:    utils.Ptr(";"),
+					},
+				},
+				// Defaults
+				Scheme:         utils.Ptr("https"),
 				ScrapeInterval: utils.Ptr("5m"),
 				ScrapeTimeout:  utils.Ptr("2m"),
 				StaticConfigs:  &[]argus.UpdateScrapeConfigPayloadStaticConfigsInner{},
@@ -270,3 +402,269 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestCountPlannedTargets(t *testing.T) {
+	targetType := map[string]attr.Type{
+		"urls":   types.ListType{ElemType: types.StringType},
+		"labels": types.MapType{ElemType: types.StringType},
+	}
+	target := func(urls types.List) attr.Value {
+		return types.ObjectValueMust(targetType, map[string]attr.Value{
+			"urls":   urls,
+			"labels": types.MapNull(types.StringType),
+		})
+	}
+	tests := []struct {
+		description   string
+		input         types.List
+		expected      int
+		expectedKnown bool
+	}{
+		{
+			"two_targets",
+			types.ListValueMust(types.ObjectType{AttrTypes: targetType}, []attr.Value{
+				target(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("url1"), types.StringValue("url2")})),
+				target(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("url3")})),
+			}),
+			3,
+			true,
+		},
+		{
+			"null_targets",
+			types.ListNull(types.ObjectType{AttrTypes: targetType}),
+			0,
+			true,
+		},
+		{
+			"unknown_targets",
+			types.ListUnknown(types.ObjectType{AttrTypes: targetType}),
+			0,
+			false,
+		},
+		{
+			"unknown_urls",
+			types.ListValueMust(types.ObjectType{AttrTypes: targetType}, []attr.Value{
+				target(types.ListUnknown(types.StringType)),
+			}),
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, known := countPlannedTargets(tt.input)
+			if known != tt.expectedKnown {
+				t.Fatalf("Expected known to be %t, got %t", tt.expectedKnown, known)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %d targets, got %d", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestCheckTargetLimit(t *testing.T) {
+	jobs := []argus.Job{
+		{
+			JobName: utils.Ptr("other"),
+			StaticConfigs: &[]argus.StaticConfigs{
+				{Targets: &[]string{"url1", "url2"}},
+				{Targets: &[]string{"url3"}},
+			},
+		},
+		{
+			JobName: utils.Ptr("name"),
+			StaticConfigs: &[]argus.StaticConfigs{
+				{Targets: &[]string{"url4", "url5", "url6"}},
+			},
+		},
+		{
+			JobName: utils.Ptr("no_static_configs"),
+		},
+	}
+	tests := []struct {
+		description    string
+		plan           *argus.PlanModel
+		plannedTargets int
+		isValid        bool
+	}{
+		{
+			"within_limit",
+			&argus.PlanModel{Name: utils.Ptr("plan"), TargetNumber: utils.Ptr(int32(5))},
+			2,
+			true,
+		},
+		{
+			"exceeds_limit",
+			&argus.PlanModel{Name: utils.Ptr("plan"), TargetNumber: utils.Ptr(int32(5))},
+			3,
+			false,
+		},
+		{
+			"no_limit",
+			&argus.PlanModel{Name: utils.Ptr("plan")},
+			100,
+			true,
+		},
+		{
+			"no_plan",
+			nil,
+			100,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkTargetLimit(tt.plan, jobs, "name", tt.plannedTargets)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestCheckRelabelConfigs(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []MetricsRelabelConfig
+		isValid     bool
+	}{
+		{
+			"none",
+			nil,
+			true,
+		},
+		{
+			"drop_ok",
+			[]MetricsRelabelConfig{
+				{Action: types.StringValue("drop"), TargetLabel: types.StringNull(), Modulus: types.Int64Null()},
+			},
+			true,
+		},
+		{
+			"replace_ok",
+			[]MetricsRelabelConfig{
+				{Action: types.StringValue("replace"), TargetLabel: types.StringValue("label"), Modulus: types.Int64Null()},
+			},
+			true,
+		},
+		{
+			"default_action_without_target_label",
+			[]MetricsRelabelConfig{
+				{Action: types.StringNull(), TargetLabel: types.StringNull(), Modulus: types.Int64Null()},
+			},
+			false,
+		},
+		{
+			"hashmod_ok",
+			[]MetricsRelabelConfig{
+				{Action: types.StringValue("hashmod"), TargetLabel: types.StringValue("shard"), Modulus: types.Int64Value(4)},
+			},
+			true,
+		},
+		{
+			"hashmod_without_modulus",
+			[]MetricsRelabelConfig{
+				{Action: types.StringValue("hashmod"), TargetLabel: types.StringValue("shard"), Modulus: types.Int64Null()},
+			},
+			false,
+		},
+		{
+			"unknown_action",
+			[]MetricsRelabelConfig{
+				{Action: types.StringUnknown(), TargetLabel: types.StringNull(), Modulus: types.Int64Null()},
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkRelabelConfigs(tt.input)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := NewScrapeConfigResource().(*scrapeConfigResource)
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	listType := objectType.AttributeTypes["metrics_relabel_configs"].(tftypes.List)
+	relabelConfigType := listType.ElementType.(tftypes.Object)
+	// config returns a configuration in which only metrics_relabel_configs is set
+	config := func(relabelConfigs tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["metrics_relabel_configs"] = relabelConfigs
+		return tftypes.NewValue(objectType, values)
+	}
+	relabelConfig := func(sourceLabels, action, targetLabel interface{}) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range relabelConfigType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["source_labels"] = tftypes.NewValue(relabelConfigType.AttributeTypes["source_labels"], sourceLabels)
+		values["action"] = tftypes.NewValue(tftypes.String, action)
+		values["target_label"] = tftypes.NewValue(tftypes.String, targetLabel)
+		return tftypes.NewValue(relabelConfigType, values)
+	}
+	tests := []struct {
+		description    string
+		relabelConfigs tftypes.Value
+		isValid        bool
+	}{
+		{
+			"not_set",
+			tftypes.NewValue(listType, nil),
+			true,
+		},
+		{
+			"unknown_list",
+			tftypes.NewValue(listType, tftypes.UnknownValue),
+			true,
+		},
+		{
+			"unknown_relabel_config",
+			tftypes.NewValue(listType, []tftypes.Value{tftypes.NewValue(relabelConfigType, tftypes.UnknownValue)}),
+			true,
+		},
+		{
+			"unknown_source_labels",
+			tftypes.NewValue(listType, []tftypes.Value{relabelConfig(tftypes.UnknownValue, "replace", "label")}),
+			true,
+		},
+		{
+			"unknown_source_labels_without_target_label",
+			tftypes.NewValue(listType, []tftypes.Value{relabelConfig(tftypes.UnknownValue, "replace", nil)}),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config(tt.relabelConfigs)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, req, resp)
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package dns_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -18,23 +19,26 @@ import (
 
 // Zone resource data
 var zoneResource = map[string]string{
-	"project_id":      testutil.ProjectId,
-	"name":            testutil.ResourceNameWithDateTime("zone"),
-	"dns_name":        fmt.Sprintf("www.%s.com", acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)),
-	"dns_name_min":    fmt.Sprintf("www.%s.com", acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)),
-	"description":     "my description",
-	"acl":             "192.168.0.0/24",
-	"active":          "true",
-	"contact_email":   "aa@bb.cc",
-	"ttl":             "12",
-	"ttl_updated":     "4440",
-	"expire_time":     "123456",
-	"is_reverse_zone": "false",
-	"negative_cache":  "60",
-	"primaries":       "1.2.3.4",
-	"refresh_time":    "500",
-	"retry_time":      "700",
-	"type":            "primary",
+	"project_id":        testutil.ProjectId,
+	"name":              testutil.ResourceNameWithDateTime("zone"),
+	"dns_name":          fmt.Sprintf("www.%s.com", acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)),
+	"dns_name_min":      fmt.Sprintf("www.%s.com", acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)),
+	"dns_name_bulk":     fmt.Sprintf("www.%s.com", acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)),
+	"dns_name_set_bulk": fmt.Sprintf("www.%s.com", acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)),
+	"dns_name_ptr":      fmt.Sprintf("%d.%d.10.in-addr.arpa", acctest.RandIntRange(0, 256), acctest.RandIntRange(0, 256)),
+	"description":       "my description",
+	"acl":               "192.168.0.0/24",
+	"active":            "true",
+	"contact_email":     "aa@bb.cc",
+	"ttl":               "12",
+	"ttl_updated":       "4440",
+	"expire_time":       "123456",
+	"is_reverse_zone":   "false",
+	"negative_cache":    "60",
+	"primaries":         "1.2.3.4",
+	"refresh_time":      "500",
+	"retry_time":        "300",
+	"type":              "primary",
 }
 
 // Record set resource data
@@ -81,6 +85,11 @@ func inputConfig(zoneName, ttl, records string) string {
 			comment    = "%s"
 			active     =  %s
 
+			timeouts {
+				create = "5m"
+				update = "5m"
+				delete = "5m"
+			}
 		}
 		`,
 		testutil.DnsProviderConfig(),
@@ -152,7 +161,7 @@ func TestAccDnsResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("stackit_dns_record_set.record_set", "record_set_id"),
 					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set", "name", recordSetResource["name"]),
 					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set", "records.#", "1"),
-					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set", "records.0", strings.ReplaceAll(recordSetResource["records"], "\"", "")),
+					resource.TestCheckTypeSetElemAttr("stackit_dns_record_set.record_set", "records.*", strings.ReplaceAll(recordSetResource["records"], "\"", "")),
 					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set", "type", recordSetResource["type"]),
 					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set", "ttl", recordSetResource["ttl"]),
 					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set", "comment", recordSetResource["comment"]),
@@ -169,10 +178,27 @@ func TestAccDnsResource(t *testing.T) {
 						zone_id    = stackit_dns_zone.zone.zone_id
 					}
 
+					data "stackit_dns_zone" "zone_by_dns_name" {
+						project_id = stackit_dns_zone.zone.project_id
+						dns_name   = stackit_dns_zone.zone.dns_name
+					}
+
+					data "stackit_dns_zones" "zones" {
+						project_id = stackit_dns_zone.zone.project_id
+						name       = stackit_dns_zone.zone.name
+					}
+
 					data "stackit_dns_record_set" "record_set" {
 						project_id = stackit_dns_zone.zone.project_id
 						zone_id    = stackit_dns_zone.zone.zone_id
 						record_set_id = stackit_dns_record_set.record_set.record_set_id
+					}
+
+					data "stackit_dns_record_sets" "record_sets" {
+						project_id = stackit_dns_zone.zone.project_id
+						zone_id    = stackit_dns_zone.zone.zone_id
+						name       = stackit_dns_record_set.record_set.name
+						type       = stackit_dns_record_set.record_set.type
 					}`,
 					inputConfig(zoneResource["name"], zoneResource["ttl"], recordSetResource["records"]),
 				),
@@ -216,6 +242,17 @@ func TestAccDnsResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.stackit_dns_zone.zone", "visibility"),
 					resource.TestCheckResourceAttrSet("data.stackit_dns_zone.zone", "state"),
 					resource.TestCheckResourceAttr("data.stackit_dns_zone.zone", "record_count", "4"),
+					resource.TestCheckResourceAttrPair(
+						"stackit_dns_zone.zone", "zone_id",
+						"data.stackit_dns_zone.zone_by_dns_name", "zone_id",
+					),
+					resource.TestCheckResourceAttr("data.stackit_dns_zones.zones", "zones.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"stackit_dns_zone.zone", "zone_id",
+						"data.stackit_dns_zones.zones", "zones.0.zone_id",
+					),
+					resource.TestCheckResourceAttr("data.stackit_dns_zones.zones", "zones.0.dns_name", zoneResource["dns_name"]),
+					resource.TestCheckResourceAttr("data.stackit_dns_zones.zones", "zones.0.acl", zoneResource["acl"]),
 
 					// Record set data
 					resource.TestCheckResourceAttrSet("data.stackit_dns_record_set.record_set", "record_set_id"),
@@ -225,6 +262,16 @@ func TestAccDnsResource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.stackit_dns_record_set.record_set", "ttl", recordSetResource["ttl"]),
 					resource.TestCheckResourceAttr("data.stackit_dns_record_set.record_set", "comment", recordSetResource["comment"]),
 					resource.TestCheckResourceAttr("data.stackit_dns_record_set.record_set", "active", recordSetResource["active"]),
+
+					// Record sets data
+					resource.TestCheckResourceAttr("data.stackit_dns_record_sets.record_sets", "record_sets.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.stackit_dns_record_sets.record_sets", "record_sets.0.record_set_id",
+						"stackit_dns_record_set.record_set", "record_set_id",
+					),
+					resource.TestCheckResourceAttr("data.stackit_dns_record_sets.record_sets", "record_sets.0.name", recordSetResource["name"]),
+					resource.TestCheckResourceAttr("data.stackit_dns_record_sets.record_sets", "record_sets.0.type", recordSetResource["type"]),
+					resource.TestCheckResourceAttr("data.stackit_dns_record_sets.record_sets", "record_sets.0.records.#", "1"),
 				),
 			},
 			// Import
@@ -263,8 +310,35 @@ func TestAccDnsResource(t *testing.T) {
 
 					return fmt.Sprintf("%s,%s,%s", testutil.ProjectId, zoneId, recordSetId), nil
 				},
-				ImportState:       true,
-				ImportStateVerify: true,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				ResourceName: "stackit_dns_record_set.record_set",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_dns_record_set.record_set"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find resource stackit_dns_record_set.record_set")
+					}
+					zoneId, ok := r.Primary.Attributes["zone_id"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find attribute zone_id")
+					}
+					name, ok := r.Primary.Attributes["name"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find attribute name")
+					}
+					recordType, ok := r.Primary.Attributes["type"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find attribute type")
+					}
+
+					return fmt.Sprintf("%s,%s,%s,%s", testutil.ProjectId, zoneId, name, recordType), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
 			},
 			// Update. The zone ttl should not be updated according to the DNS API.
 			{
@@ -392,7 +466,7 @@ func TestAccDnsMinimalResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("stackit_dns_record_set.record_set_min", "record_set_id"),
 					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set_min", "name", recordSetResource["name_min"]),
 					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set_min", "records.#", "1"),
-					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set_min", "records.0", strings.ReplaceAll(recordSetResource["records"], "\"", "")),
+					resource.TestCheckTypeSetElemAttr("stackit_dns_record_set.record_set_min", "records.*", strings.ReplaceAll(recordSetResource["records"], "\"", "")),
 					resource.TestCheckResourceAttr("stackit_dns_record_set.record_set_min", "type", recordSetResource["type"]),
 					resource.TestCheckResourceAttrSet("stackit_dns_record_set.record_set_min", "ttl"),
 					resource.TestCheckNoResourceAttr("stackit_dns_record_set.record_set_min", "comment"),
@@ -458,7 +532,7 @@ func TestAccDnsMinimalResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.stackit_dns_record_set.record_set_min", "record_set_id"),
 					resource.TestCheckResourceAttr("data.stackit_dns_record_set.record_set_min", "name", recordSetResource["name_min"]),
 					resource.TestCheckResourceAttr("data.stackit_dns_record_set.record_set_min", "records.#", "1"),
-					resource.TestCheckResourceAttr("data.stackit_dns_record_set.record_set_min", "records.0", strings.ReplaceAll(recordSetResource["records"], "\"", "")),
+					resource.TestCheckTypeSetElemAttr("data.stackit_dns_record_set.record_set_min", "records.*", strings.ReplaceAll(recordSetResource["records"], "\"", "")),
 					resource.TestCheckResourceAttr("data.stackit_dns_record_set.record_set_min", "type", recordSetResource["type"]),
 					resource.TestCheckResourceAttrSet("data.stackit_dns_record_set.record_set_min", "ttl"),
 					resource.TestCheckNoResourceAttr("data.stackit_dns_record_set.record_set_min", "comment"),
@@ -509,6 +583,236 @@ func TestAccDnsMinimalResource(t *testing.T) {
 	})
 }
 
+func inputConfigZoneRecords(zoneFile string) string {
+	return fmt.Sprintf(`
+		%s
+
+		resource "stackit_dns_zone" "zone_bulk" {
+			project_id = "%s"
+			name    = "%s"
+			dns_name = "%s"
+			contact_email = "%s"
+			type = "%s"
+			acl = "%s"
+		}
+
+		resource "stackit_dns_zone_records" "zone_records" {
+			project_id = stackit_dns_zone.zone_bulk.project_id
+			zone_id    = stackit_dns_zone.zone_bulk.zone_id
+			zone_file  = <<-EOT
+%s
+			EOT
+		}
+
+		data "stackit_dns_zone_export" "zone_export" {
+			project_id = stackit_dns_zone_records.zone_records.project_id
+			zone_id    = stackit_dns_zone_records.zone_records.zone_id
+		}
+		`,
+		testutil.DnsProviderConfig(),
+		zoneResource["project_id"],
+		zoneResource["name"],
+		zoneResource["dns_name_bulk"],
+		zoneResource["contact_email"],
+		zoneResource["type"],
+		zoneResource["acl"],
+		zoneFile,
+	)
+}
+
+func TestAccDnsZoneRecordsResource(t *testing.T) {
+	zoneFile := `$TTL 3600
+www   IN A     1.2.3.4
+www   IN A     5.6.7.8
+ftp   IN CNAME www`
+	zoneFileUpdated := `$TTL 3600
+www   IN A     1.2.3.4
+mail  IN A     9.10.11.12`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDnsDestroy,
+		Steps: []resource.TestStep{
+			// Creation
+			{
+				Config: inputConfigZoneRecords(zoneFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("stackit_dns_zone_records.zone_records", "id"),
+					resource.TestCheckResourceAttr("stackit_dns_zone_records.zone_records", "record_sets.%", "2"),
+					resource.TestCheckResourceAttrSet("stackit_dns_zone_records.zone_records", fmt.Sprintf("record_sets.www.%s. A", zoneResource["dns_name_bulk"])),
+					resource.TestCheckResourceAttrSet("stackit_dns_zone_records.zone_records", fmt.Sprintf("record_sets.ftp.%s. CNAME", zoneResource["dns_name_bulk"])),
+				),
+			},
+			// Update
+			{
+				Config: inputConfigZoneRecords(zoneFileUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_dns_zone_records.zone_records", "record_sets.%", "2"),
+					resource.TestCheckResourceAttrSet("stackit_dns_zone_records.zone_records", fmt.Sprintf("record_sets.www.%s. A", zoneResource["dns_name_bulk"])),
+					resource.TestCheckResourceAttrSet("stackit_dns_zone_records.zone_records", fmt.Sprintf("record_sets.mail.%s. A", zoneResource["dns_name_bulk"])),
+					resource.TestMatchResourceAttr("data.stackit_dns_zone_export.zone_export", "zone_file",
+						regexp.MustCompile(fmt.Sprintf(`(?m)^mail\.%s\. 3600 IN A 9\.10\.11\.12$`, regexp.QuoteMeta(zoneResource["dns_name_bulk"])))),
+				),
+			},
+			// Deletion is done by the framework implicitly
+		},
+	})
+}
+
+func inputConfigRecordSetBulk(records string) string {
+	return fmt.Sprintf(`
+		%s
+
+		resource "stackit_dns_zone" "zone_set_bulk" {
+			project_id = "%s"
+			name    = "%s"
+			dns_name = "%s"
+			contact_email = "%s"
+			type = "%s"
+		}
+
+		resource "stackit_dns_record_set_bulk" "record_set_bulk" {
+			project_id = stackit_dns_zone.zone_set_bulk.project_id
+			zone_id    = stackit_dns_zone.zone_set_bulk.zone_id
+			record_sets = [
+				%s
+			]
+		}
+		`,
+		testutil.DnsProviderConfig(),
+		zoneResource["project_id"],
+		zoneResource["name"],
+		zoneResource["dns_name_set_bulk"],
+		zoneResource["contact_email"],
+		zoneResource["type"],
+		records,
+	)
+}
+
+func TestAccDnsRecordSetBulkResource(t *testing.T) {
+	records := `{ name = "www", type = "A", ttl = 3600, records = ["1.2.3.4", "5.6.7.8"] },
+				{ name = "ftp", type = "CNAME", records = ["www.example.com."] },`
+	recordsUpdated := `{ name = "www", type = "A", ttl = 3600, records = ["1.2.3.4"] },
+				{ name = "mail", type = "A", records = ["9.10.11.12"] },`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDnsDestroy,
+		Steps: []resource.TestStep{
+			// Creation
+			{
+				Config: inputConfigRecordSetBulk(records),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("stackit_dns_record_set_bulk.record_set_bulk", "id"),
+					resource.TestCheckResourceAttr("stackit_dns_record_set_bulk.record_set_bulk", "record_sets.#", "2"),
+					resource.TestCheckResourceAttr("stackit_dns_record_set_bulk.record_set_bulk", "record_sets.0.records.#", "2"),
+					resource.TestCheckResourceAttr("stackit_dns_record_set_bulk.record_set_bulk", "record_set_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("stackit_dns_record_set_bulk.record_set_bulk", fmt.Sprintf("record_set_ids.www.%s. A", zoneResource["dns_name_set_bulk"])),
+					resource.TestCheckResourceAttrSet("stackit_dns_record_set_bulk.record_set_bulk", fmt.Sprintf("record_set_ids.ftp.%s. CNAME", zoneResource["dns_name_set_bulk"])),
+				),
+			},
+			// Update
+			{
+				Config: inputConfigRecordSetBulk(recordsUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_dns_record_set_bulk.record_set_bulk", "record_sets.#", "2"),
+					resource.TestCheckResourceAttr("stackit_dns_record_set_bulk.record_set_bulk", "record_sets.0.records.#", "1"),
+					resource.TestCheckResourceAttr("stackit_dns_record_set_bulk.record_set_bulk", "record_set_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("stackit_dns_record_set_bulk.record_set_bulk", fmt.Sprintf("record_set_ids.www.%s. A", zoneResource["dns_name_set_bulk"])),
+					resource.TestCheckResourceAttrSet("stackit_dns_record_set_bulk.record_set_bulk", fmt.Sprintf("record_set_ids.mail.%s. A", zoneResource["dns_name_set_bulk"])),
+				),
+			},
+			// Deletion is done by the framework implicitly
+		},
+	})
+}
+
+func inputConfigPtrRecord(target string) string {
+	return fmt.Sprintf(`
+		%s
+
+		resource "stackit_dns_zone" "zone_ptr" {
+			project_id = "%s"
+			name    = "%s"
+			dns_name = "%s"
+			contact_email = "%s"
+			type = "%s"
+		}
+
+		resource "stackit_dns_ptr_record" "ptr_record" {
+			project_id = stackit_dns_zone.zone_ptr.project_id
+			zone_id    = stackit_dns_zone.zone_ptr.zone_id
+			ip_address = "%s"
+			target     = "%s"
+		}
+		`,
+		testutil.DnsProviderConfig(),
+		zoneResource["project_id"],
+		zoneResource["name"],
+		zoneResource["dns_name_ptr"],
+		zoneResource["contact_email"],
+		zoneResource["type"],
+		ptrRecordIpAddress(),
+		target,
+	)
+}
+
+// ptrRecordIpAddress returns an IP address within the reverse zone of the PTR record test
+func ptrRecordIpAddress() string {
+	labels := strings.Split(strings.TrimSuffix(zoneResource["dns_name_ptr"], ".in-addr.arpa"), ".")
+	return fmt.Sprintf("%s.%s.%s.42", labels[2], labels[1], labels[0])
+}
+
+func TestAccDnsPtrRecordResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDnsDestroy,
+		Steps: []resource.TestStep{
+			// Creation
+			{
+				Config: inputConfigPtrRecord("www.example.com."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_dns_zone.zone_ptr", "is_reverse_zone", "true"),
+					resource.TestCheckResourceAttrSet("stackit_dns_ptr_record.ptr_record", "record_set_id"),
+					resource.TestCheckResourceAttr("stackit_dns_ptr_record.ptr_record", "ip_address", ptrRecordIpAddress()),
+					resource.TestCheckResourceAttr("stackit_dns_ptr_record.ptr_record", "name", fmt.Sprintf("42.%s.", zoneResource["dns_name_ptr"])),
+					resource.TestCheckResourceAttr("stackit_dns_ptr_record.ptr_record", "target", "www.example.com."),
+				),
+			},
+			// Import
+			{
+				ResourceName: "stackit_dns_ptr_record.ptr_record",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_dns_ptr_record.ptr_record"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find resource stackit_dns_ptr_record.ptr_record")
+					}
+					zoneId, ok := r.Primary.Attributes["zone_id"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find attribute zone_id")
+					}
+					recordSetId, ok := r.Primary.Attributes["record_set_id"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find attribute record_set_id")
+					}
+
+					return fmt.Sprintf("%s,%s,%s", testutil.ProjectId, zoneId, recordSetId), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				Config: inputConfigPtrRecord("mail.example.com."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_dns_ptr_record.ptr_record", "target", "mail.example.com."),
+				),
+			},
+			// Deletion is done by the framework implicitly
+		},
+	})
+}
+
 func testAccCheckDnsDestroy(s *terraform.State) error {
 	ctx := context.Background()
 	var client *dns.APIClient
@@ -530,7 +834,7 @@ func testAccCheckDnsDestroy(s *terraform.State) error {
 			continue
 		}
 		// zone terraform ID: "[projectId],[zoneId]"
-		zoneId := strings.Split(rs.Primary.ID, core.Separator)[1]
+		zoneId := core.SplitID(rs.Primary.ID)[1]
 		zonesToDestroy = append(zonesToDestroy, zoneId)
 	}
 
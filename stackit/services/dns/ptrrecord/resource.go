@@ -0,0 +1,493 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ptrRecordResource{}
+	_ resource.ResourceWithConfigure   = &ptrRecordResource{}
+	_ resource.ResourceWithImportState = &ptrRecordResource{}
+)
+
+const (
+	recordType = "PTR"
+	// waitTimeout is the timeout of the wait handlers for record set changes
+	waitTimeout = 1 * time.Minute
+)
+
+type Model struct {
+	Id          types.String `tfsdk:"id"` // needed by TF
+	RecordSetId types.String `tfsdk:"record_set_id"`
+	ZoneId      types.String `tfsdk:"zone_id"`
+	ProjectId   types.String `tfsdk:"project_id"`
+	IpAddress   types.String `tfsdk:"ip_address"`
+	Target      types.String `tfsdk:"target"`
+	Name        types.String `tfsdk:"name"`
+	TTL         types.Int64  `tfsdk:"ttl"`
+	Comment     types.String `tfsdk:"comment"`
+	Active      types.Bool   `tfsdk:"active"`
+	State       types.String `tfsdk:"state"`
+}
+
+// NewPtrRecordResource is a helper function to simplify the provider implementation.
+func NewPtrRecordResource() resource.Resource {
+	return &ptrRecordResource{}
+}
+
+// ptrRecordResource is the resource implementation.
+type ptrRecordResource struct {
+	client          *dns.APIClient
+	defaultTimeouts core.Timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *ptrRecordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_ptr_record"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ptrRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	var apiClient *dns.APIClient
+	var err error
+	if providerData.DnsCustomEndpoint != "" {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+			config.WithEndpoint(providerData.DnsCustomEndpoint),
+		)
+	} else {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "DNS PTR record client configured")
+	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
+}
+
+// Schema defines the schema for the resource.
+func (r *ptrRecordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS PTR record resource schema. Manages the record set of type `PTR` for an IP address in a reverse zone, " +
+			"deriving the record name (e.g. `4.3.2.1.in-addr.arpa.` for `1.2.3.4`) from the IP address.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the PTR record is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "The ID of the reverse zone to which the PTR record is associated. The zone must contain the reverse name of `ip_address`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"record_set_id": schema.StringAttribute{
+				Description: "The ID of the record set of type `PTR`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "The IPv4 or IPv6 address the PTR record is created for. E.g. `192.0.2.10`",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.IP(),
+				},
+			},
+			"target": schema.StringAttribute{
+				Description: "The hostname the IP address resolves to. E.g. `www.example.com.`",
+				Required:    true,
+				Validators: []validator.String{
+					validate.Hostname(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the record set derived from `ip_address`. E.g. `10.2.0.192.in-addr.arpa.`",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "Time to live. E.g. 3600",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(99999999),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Specifies if the record set is active or not.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Record set state.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ptrRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	name, err := reverseName(model.IpAddress.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating PTR record", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "name", name)
+
+	// Check the zone beforehand, as the API error for names outside the zone isn't helpful
+	zoneResp, err := r.client.GetZone(ctx, projectId, zoneId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating PTR record", fmt.Sprintf("Calling API to get zone: %v", err))
+		return
+	}
+	if zoneResp.Zone == nil || zoneResp.Zone.DnsName == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating PTR record", "Zone response is empty")
+		return
+	}
+	if !isInZone(name, *zoneResp.Zone.DnsName) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating PTR record",
+			fmt.Sprintf("The reverse name %q of IP address %q is not part of the zone %q", name, model.IpAddress.ValueString(), *zoneResp.Zone.DnsName))
+		return
+	}
+
+	// Generate API request body from model
+	payload := toCreatePayload(&model, name)
+	var recordSetResp *dns.RecordSetResponse
+	err = core.RetryOnConflict(ctx, func() error {
+		recordSetResp, err = r.client.CreateRecordSet(ctx, projectId, zoneId).CreateRecordSetPayload(*payload).Execute()
+		return err
+	})
+	if err != nil || recordSetResp.Rrset == nil || recordSetResp.Rrset.Id == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating PTR record", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	ctx = tflog.SetField(ctx, "record_set_id", *recordSetResp.Rrset.Id)
+
+	wr, err := dns.CreateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, *recordSetResp.Rrset.Id).SetTimeout(r.defaultTimeouts.CreateOr(waitTimeout)).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating PTR record", fmt.Sprintf("Record set creation waiting: %v", core.WaitError(ctx, err)))
+		return
+	}
+	got, ok := wr.(*dns.RecordSetResponse)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating PTR record", fmt.Sprintf("Wait result conversion, got %+v", got))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	err = mapFields(got, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
+		return
+	}
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "DNS PTR record created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ptrRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	recordSetId := model.RecordSetId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
+
+	recordSetResp, err := r.client.GetRecordSet(ctx, projectId, zoneId, recordSetId).Execute()
+	if err != nil {
+		if core.IsNotFound(err) {
+			tflog.Warn(ctx, "DNS PTR record not found, removing it from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading PTR record", err.Error())
+		return
+	}
+	if recordSetResp.Rrset != nil && recordSetResp.Rrset.State != nil && *recordSetResp.Rrset.State == dns.DeleteSuccess {
+		tflog.Warn(ctx, "DNS PTR record was deleted, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	err = mapFields(recordSetResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "DNS PTR record read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ptrRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	recordSetId := model.RecordSetId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
+
+	// Generate API request body from model
+	payload := toUpdatePayload(&model)
+	err := core.RetryOnConflict(ctx, func() error {
+		_, err := r.client.UpdateRecordSet(ctx, projectId, zoneId, recordSetId).UpdateRecordSetPayload(*payload).Execute()
+		return err
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating PTR record", err.Error())
+		return
+	}
+	wr, err := dns.UpdateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).SetTimeout(r.defaultTimeouts.UpdateOr(waitTimeout)).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating PTR record", fmt.Sprintf("Record set update waiting: %v", core.WaitError(ctx, err)))
+		return
+	}
+	got, ok := wr.(*dns.RecordSetResponse)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating PTR record", fmt.Sprintf("Wait result conversion, got %+v", got))
+		return
+	}
+
+	err = mapFields(got, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields in update", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "DNS PTR record updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ptrRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	recordSetId := model.RecordSetId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
+
+	_, err := r.client.DeleteRecordSet(ctx, projectId, zoneId, recordSetId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting PTR record", err.Error())
+		return
+	}
+	_, err = dns.DeleteRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).SetTimeout(r.defaultTimeouts.DeleteOr(waitTimeout)).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting PTR record", fmt.Sprintf("Record set deletion waiting: %v", core.WaitError(ctx, err)))
+		return
+	}
+	tflog.Info(ctx, "DNS PTR record deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,zone_id,record_set_id
+func (r *ptrRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := core.SplitID(req.ID)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format [project_id],[zone_id],[record_set_id], got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("record_set_id"), idParts[2])...)
+	tflog.Info(ctx, "DNS PTR record state imported")
+}
+
+func mapFields(recordSetResp *dns.RecordSetResponse, model *Model) error {
+	if recordSetResp == nil || recordSetResp.Rrset == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	recordSet := recordSetResp.Rrset
+
+	var recordSetId string
+	if model.RecordSetId.ValueString() != "" {
+		recordSetId = model.RecordSetId.ValueString()
+	} else if recordSet.Id != nil {
+		recordSetId = *recordSet.Id
+	} else {
+		return fmt.Errorf("record set id not present")
+	}
+	if recordSet.Type != nil && !strings.EqualFold(*recordSet.Type, recordType) {
+		return fmt.Errorf("record set has type %q instead of %q", *recordSet.Type, recordType)
+	}
+
+	// The IP address is only derived from the name if it isn't known yet, e.g. after an import,
+	// so the notation of the configured address is kept
+	if model.IpAddress.ValueString() == "" {
+		if recordSet.Name == nil {
+			return fmt.Errorf("record set name not present")
+		}
+		ipAddress, err := ipFromReverseName(*recordSet.Name)
+		if err != nil {
+			return fmt.Errorf("deriving IP address: %w", err)
+		}
+		model.IpAddress = types.StringValue(ipAddress)
+	}
+
+	model.Target = types.StringNull()
+	if recordSet.Records != nil && len(*recordSet.Records) > 0 {
+		if len(*recordSet.Records) > 1 {
+			return fmt.Errorf("record set has %d records, expected a single PTR record", len(*recordSet.Records))
+		}
+		model.Target = types.StringPointerValue((*recordSet.Records)[0].Content)
+	}
+
+	idParts := []string{
+		model.ProjectId.ValueString(),
+		model.ZoneId.ValueString(),
+		recordSetId,
+	}
+	model.Id = types.StringValue(
+		core.JoinID(idParts...),
+	)
+	model.RecordSetId = types.StringValue(recordSetId)
+	model.Name = types.StringPointerValue(recordSet.Name)
+	model.TTL = conversion.ToTypeInt64(recordSet.Ttl)
+	model.Comment = types.StringPointerValue(recordSet.Comment)
+	model.Active = types.BoolPointerValue(recordSet.Active)
+	model.State = types.StringPointerValue(recordSet.State)
+	return nil
+}
+
+func toCreatePayload(model *Model, name string) *dns.CreateRecordSetPayload {
+	return &dns.CreateRecordSetPayload{
+		Comment: model.Comment.ValueStringPointer(),
+		Name:    &name,
+		Records: &[]dns.RecordPayload{
+			{Content: model.Target.ValueStringPointer()},
+		},
+		Ttl:  conversion.ToPtrInt32(model.TTL),
+		Type: utils.Ptr(recordType),
+	}
+}
+
+func toUpdatePayload(model *Model) *dns.UpdateRecordSetPayload {
+	return &dns.UpdateRecordSetPayload{
+		Comment: model.Comment.ValueStringPointer(),
+		Records: &[]dns.RecordPayload{
+			{Content: model.Target.ValueStringPointer()},
+		},
+		Ttl: conversion.ToPtrInt32(model.TTL),
+	}
+}
@@ -0,0 +1,182 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       Model
+		input       *dns.RecordSetResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ZoneId:      types.StringValue("zid"),
+				RecordSetId: types.StringValue("rid"),
+				IpAddress:   types.StringValue("192.0.2.10"),
+			},
+			&dns.RecordSetResponse{
+				Rrset: &dns.RecordSet{
+					Id:   utils.Ptr("rid"),
+					Name: utils.Ptr("10.2.0.192.in-addr.arpa."),
+					Type: utils.Ptr("PTR"),
+				},
+			},
+			Model{
+				Id:          types.StringValue("pid,zid,rid"),
+				RecordSetId: types.StringValue("rid"),
+				ZoneId:      types.StringValue("zid"),
+				ProjectId:   types.StringValue("pid"),
+				IpAddress:   types.StringValue("192.0.2.10"),
+				Target:      types.StringNull(),
+				Name:        types.StringValue("10.2.0.192.in-addr.arpa."),
+				TTL:         types.Int64Null(),
+				Comment:     types.StringNull(),
+				Active:      types.BoolNull(),
+				State:       types.StringNull(),
+			},
+			true,
+		},
+		{
+			"simple_values_after_import",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ZoneId:      types.StringValue("zid"),
+				RecordSetId: types.StringValue("rid"),
+			},
+			&dns.RecordSetResponse{
+				Rrset: &dns.RecordSet{
+					Id:      utils.Ptr("rid"),
+					Name:    utils.Ptr("10.2.0.192.in-addr.arpa."),
+					Type:    utils.Ptr("PTR"),
+					Records: &[]dns.Record{{Content: utils.Ptr("www.example.com.")}},
+					Ttl:     utils.Ptr(int32(3600)),
+					Comment: utils.Ptr("comment"),
+					Active:  utils.Ptr(true),
+					State:   utils.Ptr("CREATE_SUCCEEDED"),
+				},
+			},
+			Model{
+				Id:          types.StringValue("pid,zid,rid"),
+				RecordSetId: types.StringValue("rid"),
+				ZoneId:      types.StringValue("zid"),
+				ProjectId:   types.StringValue("pid"),
+				IpAddress:   types.StringValue("192.0.2.10"),
+				Target:      types.StringValue("www.example.com."),
+				Name:        types.StringValue("10.2.0.192.in-addr.arpa."),
+				TTL:         types.Int64Value(3600),
+				Comment:     types.StringValue("comment"),
+				Active:      types.BoolValue(true),
+				State:       types.StringValue("CREATE_SUCCEEDED"),
+			},
+			true,
+		},
+		{
+			"wrong_type",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ZoneId:      types.StringValue("zid"),
+				RecordSetId: types.StringValue("rid"),
+			},
+			&dns.RecordSetResponse{
+				Rrset: &dns.RecordSet{
+					Id:   utils.Ptr("rid"),
+					Name: utils.Ptr("www.example.com."),
+					Type: utils.Ptr("A"),
+				},
+			},
+			Model{},
+			false,
+		},
+		{
+			"multiple_records",
+			Model{
+				ProjectId:   types.StringValue("pid"),
+				ZoneId:      types.StringValue("zid"),
+				RecordSetId: types.StringValue("rid"),
+				IpAddress:   types.StringValue("192.0.2.10"),
+			},
+			&dns.RecordSetResponse{
+				Rrset: &dns.RecordSet{
+					Id:      utils.Ptr("rid"),
+					Name:    utils.Ptr("10.2.0.192.in-addr.arpa."),
+					Type:    utils.Ptr("PTR"),
+					Records: &[]dns.Record{{Content: utils.Ptr("a.example.com.")}, {Content: utils.Ptr("b.example.com.")}},
+				},
+			},
+			Model{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			Model{},
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := tt.state
+			err := mapFields(tt.input, &state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	model := &Model{
+		Target:  types.StringValue("www.example.com."),
+		TTL:     types.Int64Value(3600),
+		Comment: types.StringValue("comment"),
+	}
+	expected := &dns.CreateRecordSetPayload{
+		Comment: utils.Ptr("comment"),
+		Name:    utils.Ptr("10.2.0.192.in-addr.arpa."),
+		Records: &[]dns.RecordPayload{{Content: utils.Ptr("www.example.com.")}},
+		Ttl:     utils.Ptr(int32(3600)),
+		Type:    utils.Ptr("PTR"),
+	}
+	output := toCreatePayload(model, "10.2.0.192.in-addr.arpa.")
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	model := &Model{
+		Target:  types.StringValue("www.example.com."),
+		TTL:     types.Int64Null(),
+		Comment: types.StringNull(),
+	}
+	expected := &dns.UpdateRecordSetPayload{
+		Records: &[]dns.RecordPayload{{Content: utils.Ptr("www.example.com.")}},
+	}
+	output := toUpdatePayload(model)
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
@@ -0,0 +1,90 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	ipv4ReverseDomain = "in-addr.arpa."
+	ipv6ReverseDomain = "ip6.arpa."
+)
+
+// reverseName returns the fully qualified name used for reverse DNS lookups of the IP address,
+// e.g. "4.3.2.1.in-addr.arpa." for "1.2.3.4" or the nibble format below "ip6.arpa." for IPv6 addresses.
+func reverseName(ipAddress string) (string, error) {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return "", fmt.Errorf("invalid IP address %q", ipAddress)
+	}
+
+	var b strings.Builder
+	if ipv4 := ip.To4(); ipv4 != nil {
+		for i := len(ipv4) - 1; i >= 0; i-- {
+			fmt.Fprintf(&b, "%d.", ipv4[i])
+		}
+		b.WriteString(ipv4ReverseDomain)
+		return b.String(), nil
+	}
+	ipv6 := ip.To16()
+	for i := len(ipv6) - 1; i >= 0; i-- {
+		fmt.Fprintf(&b, "%x.%x.", ipv6[i]&0xf, ipv6[i]>>4)
+	}
+	b.WriteString(ipv6ReverseDomain)
+	return b.String(), nil
+}
+
+// ipFromReverseName is the inverse of reverseName, it returns the IP address for a name used for reverse DNS lookups.
+func ipFromReverseName(name string) (string, error) {
+	fqdn := strings.ToLower(name)
+	if !strings.HasSuffix(fqdn, ".") {
+		fqdn += "."
+	}
+
+	switch {
+	case strings.HasSuffix(fqdn, "."+ipv4ReverseDomain):
+		labels := strings.Split(strings.TrimSuffix(fqdn, "."+ipv4ReverseDomain), ".")
+		if len(labels) != net.IPv4len {
+			return "", fmt.Errorf("name %q doesn't contain a complete IPv4 address", name)
+		}
+		ip := make(net.IP, net.IPv4len)
+		for i, label := range labels {
+			octet, err := strconv.ParseUint(label, 10, 8)
+			if err != nil {
+				return "", fmt.Errorf("name %q contains invalid IPv4 octet %q", name, label)
+			}
+			ip[net.IPv4len-1-i] = byte(octet)
+		}
+		return ip.String(), nil
+	case strings.HasSuffix(fqdn, "."+ipv6ReverseDomain):
+		labels := strings.Split(strings.TrimSuffix(fqdn, "."+ipv6ReverseDomain), ".")
+		if len(labels) != 2*net.IPv6len {
+			return "", fmt.Errorf("name %q doesn't contain a complete IPv6 address", name)
+		}
+		ip := make(net.IP, net.IPv6len)
+		for i, label := range labels {
+			nibble, err := strconv.ParseUint(label, 16, 4)
+			if err != nil || len(label) != 1 {
+				return "", fmt.Errorf("name %q contains invalid IPv6 nibble %q", name, label)
+			}
+			pos := 2*net.IPv6len - 1 - i
+			if pos%2 == 0 {
+				ip[pos/2] |= byte(nibble) << 4
+			} else {
+				ip[pos/2] |= byte(nibble)
+			}
+		}
+		return ip.String(), nil
+	default:
+		return "", fmt.Errorf("name %q is not below %s or %s", name, ipv4ReverseDomain, ipv6ReverseDomain)
+	}
+}
+
+// isInZone checks if the fully qualified name belongs to the zone with the given DNS name.
+func isInZone(name, zoneDnsName string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	zone := strings.ToLower(strings.TrimSuffix(zoneDnsName, "."))
+	return name == zone || strings.HasSuffix(name, "."+zone)
+}
@@ -0,0 +1,85 @@
+package dns
+
+import (
+	"testing"
+)
+
+func TestReverseName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		isValid  bool
+	}{
+		{"192.0.2.10", "10.2.0.192.in-addr.arpa.", true},
+		{"::ffff:192.0.2.10", "10.2.0.192.in-addr.arpa.", true},
+		{"2001:db8::567:89ab", "b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", true},
+		{"example.com", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			output, err := reverseName(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestIpFromReverseName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		isValid  bool
+	}{
+		{"10.2.0.192.in-addr.arpa.", "192.0.2.10", true},
+		{"10.2.0.192.IN-ADDR.ARPA", "192.0.2.10", true},
+		{"b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", "2001:db8::567:89ab", true},
+		{"2.0.192.in-addr.arpa.", "", false},
+		{"256.2.0.192.in-addr.arpa.", "", false},
+		{"b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.ip6.arpa.", "", false},
+		{"ba.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.x.ip6.arpa.", "", false},
+		{"www.example.com.", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			output, err := ipFromReverseName(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestIsInZone(t *testing.T) {
+	tests := []struct {
+		name        string
+		zoneDnsName string
+		expected    bool
+	}{
+		{"10.2.0.192.in-addr.arpa.", "2.0.192.in-addr.arpa", true},
+		{"10.2.0.192.in-addr.arpa.", "2.0.192.IN-ADDR.ARPA.", true},
+		{"10.2.0.192.in-addr.arpa.", "3.0.192.in-addr.arpa", false},
+		{"10.12.0.192.in-addr.arpa.", "2.0.192.in-addr.arpa", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" "+tt.zoneDnsName, func(t *testing.T) {
+			output := isInZone(tt.name, tt.zoneDnsName)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
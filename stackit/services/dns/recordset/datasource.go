@@ -20,6 +20,22 @@ var (
 	_ datasource.DataSource = &recordSetDataSource{}
 )
 
+// DataSourceModel is the data source model. It has neither the timeouts nor mx_records and srv_records of the resource, all records are returned in records.
+type DataSourceModel struct {
+	Id          types.String `tfsdk:"id"` // needed by TF
+	RecordSetId types.String `tfsdk:"record_set_id"`
+	ZoneId      types.String `tfsdk:"zone_id"`
+	ProjectId   types.String `tfsdk:"project_id"`
+	Active      types.Bool   `tfsdk:"active"`
+	Comment     types.String `tfsdk:"comment"`
+	Name        types.String `tfsdk:"name"`
+	Records     types.Set    `tfsdk:"records"`
+	TTL         types.Int64  `tfsdk:"ttl"`
+	Type        types.String `tfsdk:"type"`
+	Error       types.String `tfsdk:"error"`
+	State       types.String `tfsdk:"state"`
+}
+
 // NewRecordSetDataSource NewZoneDataSource is a helper function to simplify the provider implementation.
 func NewRecordSetDataSource() datasource.DataSource {
 	return &recordSetDataSource{}
@@ -52,12 +68,12 @@ func (d *recordSetDataSource) Configure(ctx context.Context, req datasource.Conf
 	var err error
 	if providerData.DnsCustomEndpoint != "" {
 		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
 			config.WithEndpoint(providerData.DnsCustomEndpoint),
 		)
 	} else {
 		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
 		)
 	}
 
@@ -83,7 +99,7 @@ func (d *recordSetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "STACKIT project ID to which the dns record set is associated.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -91,7 +107,7 @@ func (d *recordSetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "The zone ID to which is dns record set is associated.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -99,7 +115,7 @@ func (d *recordSetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "The rr set id.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -107,7 +123,7 @@ func (d *recordSetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "Name of the record which should be a valid domain according to rfc1035 Section 2.3.4. E.g. `example.com`",
 				Computed:    true,
 			},
-			"records": schema.ListAttribute{
+			"records": schema.SetAttribute{
 				Description: "Records.",
 				Computed:    true,
 				ElementType: types.StringType,
@@ -142,12 +158,16 @@ func (d *recordSetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 
 // Read refreshes the Terraform state with the latest data.
 func (d *recordSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var state Model
+	var state DataSourceModel
 	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	state.ZoneId = core.CanonicalizeIDValue(state.ZoneId)
+	state.RecordSetId = core.CanonicalizeIDValue(state.RecordSetId)
 	projectId := state.ProjectId.ValueString()
 	zoneId := state.ZoneId.ValueString()
 	recordSetId := state.RecordSetId.ValueString()
@@ -160,11 +180,17 @@ func (d *recordSetDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	err = mapFields(zoneResp, &state)
+	model := Model{
+		ProjectId:   state.ProjectId,
+		ZoneId:      state.ZoneId,
+		RecordSetId: state.RecordSetId,
+	}
+	err = mapFields(zoneResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
 		return
 	}
+	state = toDataSourceModel(&model)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -172,3 +198,20 @@ func (d *recordSetDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 	tflog.Info(ctx, "DNS record set created")
 }
+
+func toDataSourceModel(model *Model) DataSourceModel {
+	return DataSourceModel{
+		Id:          model.Id,
+		RecordSetId: model.RecordSetId,
+		ZoneId:      model.ZoneId,
+		ProjectId:   model.ProjectId,
+		Active:      model.Active,
+		Comment:     model.Comment,
+		Name:        model.Name,
+		Records:     model.Records,
+		TTL:         model.TTL,
+		Type:        model.Type,
+		Error:       model.Error,
+		State:       model.State,
+	}
+}
@@ -0,0 +1,11 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/testutil/modeltest"
+)
+
+func TestToDataSourceModel(t *testing.T) {
+	modeltest.CheckDataSourceModel(t, toDataSourceModel, "mx_records", "srv_records", "timeouts")
+}
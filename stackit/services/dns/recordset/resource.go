@@ -6,10 +6,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,24 +30,40 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &recordSetResource{}
-	_ resource.ResourceWithConfigure   = &recordSetResource{}
-	_ resource.ResourceWithImportState = &recordSetResource{}
+	_ resource.Resource                   = &recordSetResource{}
+	_ resource.ResourceWithConfigure      = &recordSetResource{}
+	_ resource.ResourceWithImportState    = &recordSetResource{}
+	_ resource.ResourceWithValidateConfig = &recordSetResource{}
+	_ resource.ResourceWithModifyPlan     = &recordSetResource{}
+	_ resource.ResourceWithUpgradeState   = &recordSetResource{}
 )
 
 type Model struct {
-	Id          types.String `tfsdk:"id"` // needed by TF
-	RecordSetId types.String `tfsdk:"record_set_id"`
-	ZoneId      types.String `tfsdk:"zone_id"`
-	ProjectId   types.String `tfsdk:"project_id"`
-	Active      types.Bool   `tfsdk:"active"`
-	Comment     types.String `tfsdk:"comment"`
-	Name        types.String `tfsdk:"name"`
-	Records     types.List   `tfsdk:"records"`
-	TTL         types.Int64  `tfsdk:"ttl"`
-	Type        types.String `tfsdk:"type"`
-	Error       types.String `tfsdk:"error"`
-	State       types.String `tfsdk:"state"`
+	Id          types.String   `tfsdk:"id"` // needed by TF
+	RecordSetId types.String   `tfsdk:"record_set_id"`
+	ZoneId      types.String   `tfsdk:"zone_id"`
+	ProjectId   types.String   `tfsdk:"project_id"`
+	Active      types.Bool     `tfsdk:"active"`
+	Comment     types.String   `tfsdk:"comment"`
+	Name        types.String   `tfsdk:"name"`
+	Records     types.Set      `tfsdk:"records"`
+	MxRecords   types.Set      `tfsdk:"mx_records"`
+	SrvRecords  types.Set      `tfsdk:"srv_records"`
+	TTL         types.Int64    `tfsdk:"ttl"`
+	Type        types.String   `tfsdk:"type"`
+	Error       types.String   `tfsdk:"error"`
+	State       types.String   `tfsdk:"state"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// defaultTimeout is used by the wait handlers if no timeout is configured in the timeouts block or in the default_timeouts block of the provider.
+const defaultTimeout = 1 * time.Minute
+
+// timeoutsAttributeTypes are the attribute types of the timeouts block, needed to create a null value for it.
+var timeoutsAttributeTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
 }
 
 // NewRecordSetResource is a helper function to simplify the provider implementation.
@@ -55,7 +73,9 @@ func NewRecordSetResource() resource.Resource {
 
 // recordSetResource is the resource implementation.
 type recordSetResource struct {
-	client *dns.APIClient
+	client          *dns.APIClient
+	strict          bool
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -80,12 +100,12 @@ func (r *recordSetResource) Configure(ctx context.Context, req resource.Configur
 	var err error
 	if providerData.DnsCustomEndpoint != "" {
 		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
 			config.WithEndpoint(providerData.DnsCustomEndpoint),
 		)
 	} else {
 		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
 		)
 	}
 
@@ -96,12 +116,15 @@ func (r *recordSetResource) Configure(ctx context.Context, req resource.Configur
 
 	tflog.Debug(ctx, "DNS record set client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
+	r.strict = providerData.Strict
 }
 
 // Schema defines the schema for the resource.
-func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *recordSetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "DNS Record Set Resource schema.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Terraform's internal resource ID.",
@@ -144,21 +167,94 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the record which should be a valid domain according to rfc1035 Section 2.3.4. E.g. `example.com`",
-				Required:    true,
+				Description: "Name of the record which should be a valid domain according to rfc1035 Section 2.3.4. E.g. `example.com`. " +
+					"The name may have up to 253 characters and each label up to 63 characters. A wildcard is only allowed as the leftmost label, e.g. `*.example.com`.",
+				Required: true,
 				Validators: []validator.String{
-					stringvalidator.LengthAtLeast(1),
-					stringvalidator.LengthAtMost(63),
+					validate.RecordSetName(),
 				},
 			},
-			"records": schema.ListAttribute{
-				Description: "Records.",
+			"records": schema.SetAttribute{
+				Description: "Records. The records are validated according to the record set `type`, e.g. IP addresses for `A` and `AAAA`, " +
+					"hostnames for `CNAME`, `NS` and `PTR`, `<preference> <exchange>` for `MX`, `<priority> <weight> <port> <target>` for `SRV` and `<flags> <tag> <value>` for `CAA`. " +
+					"`TXT` records that differ only in how their text is split into quoted strings, e.g. `\"v=spf1 \" \"-all\"` and `v=spf1 -all`, are considered equal. " +
+					"Exactly one of `records`, `mx_records` and `srv_records` must be set. If `mx_records` or `srv_records` is set, the records are computed from it.",
 				ElementType: types.StringType,
-				Required:    true,
-				Validators: []validator.List{
-					listvalidator.SizeAtLeast(1),
-					listvalidator.UniqueValues(),
-					listvalidator.ValueStringsAre(validate.IP()),
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ExactlyOneOf(
+						path.MatchRoot("mx_records"),
+						path.MatchRoot("srv_records"),
+					),
+				},
+			},
+			"mx_records": schema.SetNestedAttribute{
+				Description: "MX records, as an alternative to the `<preference> <exchange>` strings in `records`. Can only be used if `type` is `MX`.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							Description: "Preference of the mail exchange, lower values are preferred. E.g. `10`.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"exchange": schema.StringAttribute{
+							Description: "Hostname of the mail exchange. E.g. `mail.example.com.`.",
+							Required:    true,
+							Validators: []validator.String{
+								validate.Hostname(),
+							},
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"srv_records": schema.SetNestedAttribute{
+				Description: "SRV records, as an alternative to the `<priority> <weight> <port> <target>` strings in `records`. Can only be used if `type` is `SRV`.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							Description: "Priority of the target, lower values are preferred. E.g. `10`.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"weight": schema.Int64Attribute{
+							Description: "Relative weight of targets with the same priority. E.g. `5`.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"port": schema.Int64Attribute{
+							Description: "Port of the service on the target. E.g. `5060`.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"target": schema.StringAttribute{
+							Description: "Hostname of the target, or `.` if the service is decidedly not available. E.g. `sip.example.com.`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.Any(
+									stringvalidator.OneOf("."),
+									validate.Hostname(),
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
 				},
 			},
 			"ttl": schema.Int64Attribute{
@@ -171,7 +267,7 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"type": schema.StringAttribute{
-				Description: "The record set type. E.g. `A` or `CNAME`",
+				Description: "The record set type. E.g. `A` or `CNAME`. A `CNAME` record set is neither allowed at the zone apex nor next to record sets of other types with the same name.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -204,7 +300,238 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Update:            true,
+				Delete:            true,
+				CreateDescription: "Timeout for waiting on the record set creation, e.g. `5m`. Defaults to the `default_timeouts` of the provider or, if not set there, to `1m`.",
+				UpdateDescription: "Timeout for waiting on the record set update, e.g. `5m`. Defaults to the `default_timeouts` of the provider or, if not set there, to `1m`.",
+				DeleteDescription: "Timeout for waiting on the record set deletion, e.g. `5m`. Defaults to the `default_timeouts` of the provider or, if not set there, to `1m`.",
+			}),
+		},
+	}
+}
+
+func (r *recordSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = validate.DNSRecords(ctx, model.Type, model.Records, path.Root("records"))
+	resp.Diagnostics.Append(diags...)
+	diags = checkStructuredRecords(model.Type, model.MxRecords, model.SrvRecords)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan computes the records from mx_records or srv_records, if configured.
+// On create or if the name or type change, it also checks the planned record set against the zone and its existing record sets,
+// so that CNAME conflicts are reported at plan time instead of being rejected by the API on apply.
+func (r *recordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var mxRecords, srvRecords types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("mx_records"), &mxRecords)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("srv_records"), &srvRecords)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	records, err := structuredRecords(ctx, mxRecords, srvRecords)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error planning records", err.Error())
+		return
+	}
+	if !records.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), records)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var projectId, zoneId, recordSetId, name, recordType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone_id"), &zoneId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("record_set_id"), &recordSetId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &recordType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The conflicts can't be checked if the provider isn't configured yet
+	if projectId.IsUnknown() || zoneId.IsUnknown() || name.IsUnknown() || recordType.IsUnknown() || recordType.IsNull() || r.client == nil {
+		return
+	}
+	// The conflicts are only checked on create or if the name or type change, so unchanged record sets don't cause API calls on every plan
+	if !req.State.Raw.IsNull() {
+		var stateName, stateType types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if name.Equal(stateName) && recordType.Equal(stateType) {
+			return
+		}
+	}
+	ctx = tflog.SetField(ctx, "project_id", projectId.ValueString())
+	ctx = tflog.SetField(ctx, "zone_id", zoneId.ValueString())
+
+	zoneResp, err := r.client.GetZone(ctx, projectId.ValueString(), zoneId.ValueString()).Execute()
+	if err != nil || zoneResp.Zone == nil || zoneResp.Zone.DnsName == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping validation of CNAME conflicts, the zone couldn't be read: %v", err))
+		return
+	}
+	listResp, err := r.client.GetRecordSets(ctx, projectId.ValueString(), zoneId.ValueString()).NameEq(toFQDN(name.ValueString())).StateNeq(dns.DeleteSuccess).Execute()
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping validation of CNAME conflicts, the record sets couldn't be listed: %v", err))
+		return
+	}
+	existing := []dns.RecordSet{}
+	if listResp.RrSets != nil {
+		existing = *listResp.RrSets
+	}
+	resp.Diagnostics.Append(checkCnameConflicts(name.ValueString(), recordType.ValueString(), recordSetId.ValueString(), *zoneResp.Zone.DnsName, existing)...)
+}
+
+// checkCnameConflicts checks that a CNAME record set is neither at the zone apex nor shares its name with record sets of other types,
+// and that no other record set is added to a name that already has a CNAME record set, as forbidden by RFC 1034 Section 3.6.2.
+// The record set itself, identified by recordSetId, is ignored among the existing record sets.
+func checkCnameConflicts(name, recordType, recordSetId, zoneDnsName string, existing []dns.RecordSet) diag.Diagnostics {
+	var diags diag.Diagnostics
+	isCname := strings.EqualFold(recordType, "CNAME")
+	if isCname && strings.EqualFold(toFQDN(name), toFQDN(zoneDnsName)) {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"CNAME record at zone apex",
+			fmt.Sprintf("The record set %q is at the apex of zone %q, where a CNAME record is not allowed. Use an A or AAAA record set instead.", name, zoneDnsName),
+		)
+		return diags
+	}
+
+	for _, s := range existing {
+		if s.Name == nil || s.Type == nil || !strings.EqualFold(toFQDN(*s.Name), toFQDN(name)) {
+			continue
+		}
+		if s.Id != nil && *s.Id == recordSetId {
+			continue
+		}
+		if s.State != nil && *s.State == dns.DeleteSuccess {
+			continue
+		}
+		existingIsCname := strings.EqualFold(*s.Type, "CNAME")
+		if !isCname && !existingIsCname {
+			continue
+		}
+		if isCname && existingIsCname {
+			// Two record sets with the same name and type are rejected with a meaningful error by the API
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root("type"),
+			"CNAME conflict",
+			fmt.Sprintf("The name %q already has a record set of type %q. A CNAME record can't coexist with other records of the same name.", name, *s.Type),
+		)
+		return diags
+	}
+	return diags
+}
+
+// modelV0 is the state model of schema version 0, in which records were stored as a list
+type modelV0 struct {
+	Id          types.String `tfsdk:"id"`
+	RecordSetId types.String `tfsdk:"record_set_id"`
+	ZoneId      types.String `tfsdk:"zone_id"`
+	ProjectId   types.String `tfsdk:"project_id"`
+	Active      types.Bool   `tfsdk:"active"`
+	Comment     types.String `tfsdk:"comment"`
+	Name        types.String `tfsdk:"name"`
+	Records     types.List   `tfsdk:"records"`
+	TTL         types.Int64  `tfsdk:"ttl"`
+	Type        types.String `tfsdk:"type"`
+	Error       types.String `tfsdk:"error"`
+	State       types.String `tfsdk:"state"`
+}
+
+// UpgradeState upgrades the state of older schema versions to the current one.
+func (r *recordSetResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":            schema.StringAttribute{Computed: true},
+					"project_id":    schema.StringAttribute{Required: true},
+					"zone_id":       schema.StringAttribute{Required: true},
+					"record_set_id": schema.StringAttribute{Computed: true},
+					"name":          schema.StringAttribute{Required: true},
+					"records":       schema.ListAttribute{ElementType: types.StringType, Required: true},
+					"ttl":           schema.Int64Attribute{Optional: true, Computed: true},
+					"type":          schema.StringAttribute{Optional: true, Computed: true},
+					"active":        schema.BoolAttribute{Optional: true, Computed: true},
+					"comment":       schema.StringAttribute{Optional: true, Computed: true},
+					"error":         schema.StringAttribute{Computed: true},
+					"state":         schema.StringAttribute{Computed: true},
+				},
+			},
+			StateUpgrader: upgradeStateV0,
+		},
+	}
+}
+
+func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var priorModel modelV0
+	diags := req.State.Get(ctx, &priorModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := upgradeModelV0(&priorModel)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error upgrading record set state", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+func upgradeModelV0(priorModel *modelV0) (*Model, error) {
+	if priorModel == nil {
+		return nil, fmt.Errorf("prior model input is nil")
+	}
+	records := types.SetNull(types.StringType)
+	if !priorModel.Records.IsNull() {
+		var diags diag.Diagnostics
+		records, diags = types.SetValue(types.StringType, priorModel.Records.Elements())
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting records to set: %w", core.DiagsToError(diags))
+		}
 	}
+	return &Model{
+		Id:          priorModel.Id,
+		RecordSetId: priorModel.RecordSetId,
+		ZoneId:      priorModel.ZoneId,
+		ProjectId:   priorModel.ProjectId,
+		Active:      priorModel.Active,
+		Comment:     priorModel.Comment,
+		Name:        priorModel.Name,
+		Records:     records,
+		MxRecords:   types.SetNull(types.ObjectType{AttrTypes: mxRecordTypes}),
+		SrvRecords:  types.SetNull(types.ObjectType{AttrTypes: srvRecordTypes}),
+		TTL:         priorModel.TTL,
+		Type:        priorModel.Type,
+		Error:       priorModel.Error,
+		State:       priorModel.State,
+		Timeouts:    timeouts.Value{Object: types.ObjectNull(timeoutsAttributeTypes)},
+	}, nil
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -228,17 +555,26 @@ func (r *recordSetResource) Create(ctx context.Context, req resource.CreateReque
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating recordset", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
-	// Create new recordset
-	recordSetResp, err := r.client.CreateRecordSet(ctx, projectId, zoneId).CreateRecordSetPayload(*payload).Execute()
+	// Create new recordset, retrying if the zone is changed concurrently
+	var recordSetResp *dns.RecordSetResponse
+	err = core.RetryOnConflict(ctx, func() error {
+		recordSetResp, err = r.client.CreateRecordSet(ctx, projectId, zoneId).CreateRecordSetPayload(*payload).Execute()
+		return err
+	})
 	if err != nil || recordSetResp.Rrset == nil || recordSetResp.Rrset.Id == nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating recordset", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
 	ctx = tflog.SetField(ctx, "record_set_id", *recordSetResp.Rrset.Id)
 
-	wr, err := dns.CreateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, *recordSetResp.Rrset.Id).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	createTimeout, diags := model.Timeouts.Create(ctx, r.defaultTimeouts.CreateOr(defaultTimeout))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	wr, err := dns.CreateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, *recordSetResp.Rrset.Id).SetTimeout(createTimeout).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating recordset", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating recordset", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*dns.RecordSetResponse)
@@ -276,7 +612,17 @@ func (r *recordSetResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	recordSetResp, err := r.client.GetRecordSet(ctx, projectId, zoneId, recordSetId).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zones", err.Error())
+		if core.IsNotFound(err) {
+			tflog.Warn(ctx, "DNS record set not found, removing it from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading record set", err.Error())
+		return
+	}
+	if isDeleted(recordSetResp) {
+		tflog.Warn(ctx, "DNS record set was deleted, removing it from state")
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -286,6 +632,7 @@ func (r *recordSetResource) Read(ctx context.Context, req resource.ReadRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	checkRecordSetStatus(ctx, &resp.Diagnostics, recordSetResp, r.strict)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
@@ -316,15 +663,23 @@ func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateReque
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating recordset", fmt.Sprintf("Could not create API payload: %v", err))
 		return
 	}
-	// Update recordset
-	_, err = r.client.UpdateRecordSet(ctx, projectId, zoneId, recordSetId).UpdateRecordSetPayload(*payload).Execute()
+	// Update recordset, retrying if the zone is changed concurrently
+	err = core.RetryOnConflict(ctx, func() error {
+		_, err := r.client.UpdateRecordSet(ctx, projectId, zoneId, recordSetId).UpdateRecordSetPayload(*payload).Execute()
+		return err
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating recordset", err.Error())
 		return
 	}
-	wr, err := dns.UpdateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	updateTimeout, diags := model.Timeouts.Update(ctx, r.defaultTimeouts.UpdateOr(defaultTimeout))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	wr, err := dns.UpdateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).SetTimeout(updateTimeout).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating recordset", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating recordset", fmt.Sprintf("Instance update waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*dns.RecordSetResponse)
@@ -371,9 +726,14 @@ func (r *recordSetResource) Delete(ctx context.Context, req resource.DeleteReque
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting recordset", err.Error())
 	}
-	_, err = dns.DeleteRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	deleteTimeout, diags := model.Timeouts.Delete(ctx, r.defaultTimeouts.DeleteOr(defaultTimeout))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err = dns.DeleteRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).SetTimeout(deleteTimeout).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record set", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record set", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "DNS record set deleted")
@@ -381,22 +741,166 @@ func (r *recordSetResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,zone_id,record_set_id
+// Alternatively, the record set can be identified by its name and type: project_id,zone_id,name,type
+// Commas and backslashes in a part are escaped with a backslash, see core.SplitID
 func (r *recordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+	idParts := core.SplitID(req.ID)
+	validParts := len(idParts) == 3 || len(idParts) == 4
+	for _, part := range idParts {
+		if part == "" {
+			validParts = false
+		}
+	}
+	if !validParts {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format [project_id],[zone_id],[record_set_id], got %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format [project_id],[zone_id],[record_set_id] or [project_id],[zone_id],[name],[type], got %q", req.ID),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("record_set_id"), idParts[2])...)
+	projectId := idParts[0]
+	zoneId := idParts[1]
+	recordSetId := idParts[2]
+	if len(idParts) == 4 {
+		name := idParts[2]
+		recordType := idParts[3]
+		ctx = tflog.SetField(ctx, "name", name)
+		ctx = tflog.SetField(ctx, "type", recordType)
+		listResp, err := r.client.GetRecordSets(ctx, projectId, zoneId).
+			NameEq(toFQDN(name)).
+			TypeEq(strings.ToUpper(recordType)).
+			StateNeq(dns.DeleteSuccess).
+			Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing record set", fmt.Sprintf("Calling API to list record sets: %v", err))
+			return
+		}
+		recordSetId, err = selectRecordSetId(listResp, name, recordType)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing record set", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("record_set_id"), recordSetId)...)
 	tflog.Info(ctx, "DNS record set state imported")
 }
 
+// isDeleted checks if the record set in the response was deleted, e.g. outside of Terraform.
+func isDeleted(recordSetResp *dns.RecordSetResponse) bool {
+	if recordSetResp == nil || recordSetResp.Rrset == nil || recordSetResp.Rrset.State == nil {
+		return false
+	}
+	return *recordSetResp.Rrset.State == dns.DeleteSuccess
+}
+
+// checkRecordSetStatus adds a warning if the API reports that the last change of the record set failed,
+// e.g. because of an invalid record, so broken record sets show up in plans.
+// The API reports the status of the whole record set only, not of the single records.
+func checkRecordSetStatus(ctx context.Context, diags *diag.Diagnostics, recordSetResp *dns.RecordSetResponse, strict bool) {
+	if recordSetResp == nil || recordSetResp.Rrset == nil {
+		return
+	}
+	rs := recordSetResp.Rrset
+	failed := rs.State != nil && (*rs.State == dns.CreateFail || *rs.State == dns.UpdateFail || *rs.State == dns.DeleteFail)
+	hasError := rs.Error != nil && *rs.Error != ""
+	if !failed && !hasError {
+		return
+	}
+
+	name := ""
+	if rs.Name != nil {
+		name = *rs.Name
+	}
+	detail := fmt.Sprintf("The record set %q", name)
+	if rs.State != nil {
+		detail += fmt.Sprintf(" is in state %q", *rs.State)
+	} else {
+		detail += " has an error"
+	}
+	if hasError {
+		detail += fmt.Sprintf(": %s", *rs.Error)
+	}
+	core.LogAndAddWarning(ctx, diags, strict, "DNS record set has errors", detail+". Check the records of the record set, they may not be served.")
+}
+
+// toFQDN appends the trailing dot to name, as the API returns record set names as fully qualified domain names.
+func toFQDN(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// mapRecords maps the records returned by the API.
+// The API re-chunks the content of TXT records and may qualify the hostnames of CNAME, NS, PTR, MX and SRV records,
+// so a record that is equal to a prior record after canonicalization keeps the prior value.
+// This avoids perpetual diffs, e.g. on long SPF or DKIM records.
+func mapRecords(records *[]dns.Record, recordType *string, prior types.Set) (types.Set, error) {
+	if records == nil {
+		return types.SetNull(types.StringType), nil
+	}
+	recordTypeString := ""
+	if recordType != nil {
+		recordTypeString = *recordType
+	}
+	priorRecords := map[string]string{}
+	for _, record := range prior.Elements() {
+		recordString, ok := record.(types.String)
+		if !ok || recordString.IsNull() || recordString.IsUnknown() {
+			continue
+		}
+		priorRecords[core.CanonicalDNSRecord(recordTypeString, recordString.ValueString())] = recordString.ValueString()
+	}
+	values := []attr.Value{}
+	for _, record := range *records {
+		if record.Content != nil {
+			if priorRecord, ok := priorRecords[core.CanonicalDNSRecord(recordTypeString, *record.Content)]; ok {
+				values = append(values, types.StringValue(priorRecord))
+				continue
+			}
+		}
+		values = append(values, types.StringPointerValue(record.Content))
+	}
+	recordsSet, diags := types.SetValue(types.StringType, values)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), fmt.Errorf("failed to map records: %w", core.DiagsToError(diags))
+	}
+	return recordsSet, nil
+}
+
+// selectRecordSetId returns the id of the only record set in the list response with the given name and type.
+// Names are compared case-insensitively and regardless of the trailing dot.
+func selectRecordSetId(listResp *dns.RecordSetsResponse, name, recordType string) (string, error) {
+	if listResp == nil || listResp.RrSets == nil {
+		return "", fmt.Errorf("no record set found with name %q and type %q", name, recordType)
+	}
+	ids := []string{}
+	for _, recordSet := range *listResp.RrSets {
+		if recordSet.Name == nil || recordSet.Type == nil || recordSet.Id == nil {
+			continue
+		}
+		if recordSet.State != nil && *recordSet.State == dns.DeleteSuccess {
+			continue
+		}
+		if !strings.EqualFold(toFQDN(*recordSet.Name), toFQDN(name)) || !strings.EqualFold(*recordSet.Type, recordType) {
+			continue
+		}
+		ids = append(ids, *recordSet.Id)
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no record set found with name %q and type %q", name, recordType)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d record sets with name %q and type %q, import it by its record set ID instead", len(ids), name, recordType)
+	}
+}
+
 func mapFields(recordSetResp *dns.RecordSetResponse, model *Model) error {
 	if recordSetResp == nil || recordSetResp.Rrset == nil {
 		return fmt.Errorf("response input is nil")
@@ -415,18 +919,14 @@ func mapFields(recordSetResp *dns.RecordSetResponse, model *Model) error {
 		return fmt.Errorf("record set id not present")
 	}
 
-	if recordSet.Records == nil {
-		model.Records = types.ListNull(types.StringType)
-	} else {
-		records := []attr.Value{}
-		for _, record := range *recordSet.Records {
-			records = append(records, types.StringPointerValue(record.Content))
-		}
-		recordsList, diags := types.ListValue(types.StringType, records)
-		if diags.HasError() {
-			return fmt.Errorf("failed to map records: %w", core.DiagsToError(diags))
-		}
-		model.Records = recordsList
+	records, err := mapRecords(recordSet.Records, recordSet.Type, model.Records)
+	if err != nil {
+		return err
+	}
+	model.Records = records
+	err = mapStructuredRecords(model)
+	if err != nil {
+		return err
 	}
 	idParts := []string{
 		model.ProjectId.ValueString(),
@@ -434,7 +934,7 @@ func mapFields(recordSetResp *dns.RecordSetResponse, model *Model) error {
 		recordSetId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.RecordSetId = types.StringPointerValue(recordSet.Id)
 	model.Active = types.BoolPointerValue(recordSet.Active)
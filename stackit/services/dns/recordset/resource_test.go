@@ -1,11 +1,21 @@
 package dns
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/dns"
 )
@@ -33,7 +43,9 @@ func TestMapFields(t *testing.T) {
 				Comment:     types.StringNull(),
 				Error:       types.StringNull(),
 				Name:        types.StringNull(),
-				Records:     types.ListNull(types.StringType),
+				Records:     types.SetNull(types.StringType),
+				MxRecords:   types.SetNull(types.ObjectType{AttrTypes: mxRecordTypes}),
+				SrvRecords:  types.SetNull(types.ObjectType{AttrTypes: srvRecordTypes}),
 				State:       types.StringNull(),
 				TTL:         types.Int64Null(),
 				Type:        types.StringNull(),
@@ -67,13 +79,15 @@ func TestMapFields(t *testing.T) {
 				Comment:     types.StringValue("comment"),
 				Error:       types.StringValue("error"),
 				Name:        types.StringValue("name"),
-				Records: types.ListValueMust(types.StringType, []attr.Value{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
 					types.StringValue("record_1"),
 					types.StringValue("record_2"),
 				}),
-				State: types.StringValue("state"),
-				TTL:   types.Int64Value(1),
-				Type:  types.StringValue("type"),
+				MxRecords:  types.SetNull(types.ObjectType{AttrTypes: mxRecordTypes}),
+				SrvRecords: types.SetNull(types.ObjectType{AttrTypes: srvRecordTypes}),
+				State:      types.StringValue("state"),
+				TTL:        types.Int64Value(1),
+				Type:       types.StringValue("type"),
 			},
 			true,
 		},
@@ -101,7 +115,9 @@ func TestMapFields(t *testing.T) {
 				Comment:     types.StringNull(),
 				Error:       types.StringNull(),
 				Name:        types.StringValue("name"),
-				Records:     types.ListNull(types.StringType),
+				Records:     types.SetNull(types.StringType),
+				MxRecords:   types.SetNull(types.ObjectType{AttrTypes: mxRecordTypes}),
+				SrvRecords:  types.SetNull(types.ObjectType{AttrTypes: srvRecordTypes}),
 				State:       types.StringValue("state"),
 				TTL:         types.Int64Value(2123456789),
 				Type:        types.StringValue("type"),
@@ -124,8 +140,10 @@ func TestMapFields(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
 			state := &Model{
-				ProjectId: tt.expected.ProjectId,
-				ZoneId:    tt.expected.ZoneId,
+				ProjectId:  tt.expected.ProjectId,
+				ZoneId:     tt.expected.ZoneId,
+				MxRecords:  tt.expected.MxRecords,
+				SrvRecords: tt.expected.SrvRecords,
 			}
 			err := mapFields(tt.input, state)
 			if !tt.isValid && err == nil {
@@ -144,6 +162,102 @@ func TestMapFields(t *testing.T) {
 	}
 }
 
+func TestMapRecords(t *testing.T) {
+	tests := []struct {
+		description string
+		records     *[]dns.Record
+		recordType  *string
+		prior       types.Set
+		expected    types.Set
+	}{
+		{
+			"nil_records",
+			nil,
+			utils.Ptr("TXT"),
+			types.SetNull(types.StringType),
+			types.SetNull(types.StringType),
+		},
+		{
+			"no_prior_records",
+			&[]dns.Record{
+				{Content: utils.Ptr("\"v=spf1 \" \"-all\"")},
+			},
+			utils.Ptr("TXT"),
+			types.SetNull(types.StringType),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("\"v=spf1 \" \"-all\""),
+			}),
+		},
+		{
+			"rechunked_txt_record",
+			&[]dns.Record{
+				{Content: utils.Ptr("\"v=DKIM1; k=rsa; \" \"p=abc\"")},
+				{Content: utils.Ptr("\"other\"")},
+			},
+			utils.Ptr("TXT"),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("v=DKIM1; k=rsa; p=abc"),
+				types.StringValue("removed"),
+			}),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("v=DKIM1; k=rsa; p=abc"),
+				types.StringValue("\"other\""),
+			}),
+		},
+		{
+			"lowercase_type",
+			&[]dns.Record{
+				{Content: utils.Ptr("\"text\"")},
+			},
+			utils.Ptr("txt"),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("text"),
+			}),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("text"),
+			}),
+		},
+		{
+			"qualified_mx_record",
+			&[]dns.Record{
+				{Content: utils.Ptr("10 mail.example.com.")},
+			},
+			utils.Ptr("MX"),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("10  Mail.example.com"),
+			}),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("10  Mail.example.com"),
+			}),
+		},
+		{
+			"other_type",
+			&[]dns.Record{
+				{Content: utils.Ptr("\"text\"")},
+			},
+			utils.Ptr("CAA"),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("text"),
+			}),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("\"text\""),
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapRecords(tt.records, tt.recordType, tt.prior)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
 func TestToCreatePayload(t *testing.T) {
 	tests := []struct {
 		description string
@@ -164,7 +278,7 @@ func TestToCreatePayload(t *testing.T) {
 			&Model{
 				Comment: types.StringValue("comment"),
 				Name:    types.StringValue("name"),
-				Records: types.ListValueMust(types.StringType, []attr.Value{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
 					types.StringValue("record_1"),
 					types.StringValue("record_2"),
 				}),
@@ -188,7 +302,7 @@ func TestToCreatePayload(t *testing.T) {
 			&Model{
 				Comment: types.StringNull(),
 				Name:    types.StringValue(""),
-				Records: types.ListValueMust(types.StringType, nil),
+				Records: types.SetValueMust(types.StringType, nil),
 				TTL:     types.Int64Value(2123456789),
 				Type:    types.StringValue(""),
 			},
@@ -247,7 +361,7 @@ func TestToUpdatePayload(t *testing.T) {
 			&Model{
 				Comment: types.StringValue("comment"),
 				Name:    types.StringValue("name"),
-				Records: types.ListValueMust(types.StringType, []attr.Value{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
 					types.StringValue("record_1"),
 					types.StringValue("record_2"),
 				}),
@@ -269,7 +383,7 @@ func TestToUpdatePayload(t *testing.T) {
 			&Model{
 				Comment: types.StringNull(),
 				Name:    types.StringValue(""),
-				Records: types.ListValueMust(types.StringType, nil),
+				Records: types.SetValueMust(types.StringType, nil),
 				TTL:     types.Int64Value(2123456789),
 			},
 			&dns.UpdateRecordSetPayload{
@@ -305,3 +419,381 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestUpgradeModelV0(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *modelV0
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"ok",
+			&modelV0{
+				Id:          types.StringValue("pid,zid,rid"),
+				RecordSetId: types.StringValue("rid"),
+				ZoneId:      types.StringValue("zid"),
+				ProjectId:   types.StringValue("pid"),
+				Active:      types.BoolValue(true),
+				Comment:     types.StringValue("comment"),
+				Name:        types.StringValue("name"),
+				Records: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("record_2"),
+					types.StringValue("record_1"),
+				}),
+				TTL:   types.Int64Value(1),
+				Type:  types.StringValue("A"),
+				Error: types.StringNull(),
+				State: types.StringValue("CREATE_SUCCEEDED"),
+			},
+			&Model{
+				Id:          types.StringValue("pid,zid,rid"),
+				RecordSetId: types.StringValue("rid"),
+				ZoneId:      types.StringValue("zid"),
+				ProjectId:   types.StringValue("pid"),
+				Active:      types.BoolValue(true),
+				Comment:     types.StringValue("comment"),
+				Name:        types.StringValue("name"),
+				Records: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("record_1"),
+					types.StringValue("record_2"),
+				}),
+				MxRecords:  types.SetNull(types.ObjectType{AttrTypes: mxRecordTypes}),
+				SrvRecords: types.SetNull(types.ObjectType{AttrTypes: srvRecordTypes}),
+				TTL:        types.Int64Value(1),
+				Type:       types.StringValue("A"),
+				Error:      types.StringNull(),
+				State:      types.StringValue("CREATE_SUCCEEDED"),
+				Timeouts:   timeouts.Value{Object: types.ObjectNull(timeoutsAttributeTypes)},
+			},
+			true,
+		},
+		{
+			"null_records",
+			&modelV0{
+				Records: types.ListNull(types.StringType),
+			},
+			&Model{
+				Records:    types.SetNull(types.StringType),
+				MxRecords:  types.SetNull(types.ObjectType{AttrTypes: mxRecordTypes}),
+				SrvRecords: types.SetNull(types.ObjectType{AttrTypes: srvRecordTypes}),
+				Timeouts:   timeouts.Value{Object: types.ObjectNull(timeoutsAttributeTypes)},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := upgradeModelV0(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSelectRecordSetId(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *dns.RecordSetsResponse
+		name        string
+		recordType  string
+		expected    string
+		isValid     bool
+	}{
+		{
+			"single_match",
+			&dns.RecordSetsResponse{
+				RrSets: &[]dns.RecordSet{
+					{Id: utils.Ptr("rid-1"), Name: utils.Ptr("www.example.com."), Type: utils.Ptr("A")},
+					{Id: utils.Ptr("rid-2"), Name: utils.Ptr("www.example.com."), Type: utils.Ptr("AAAA")},
+				},
+			},
+			"WWW.example.com",
+			"a",
+			"rid-1",
+			true,
+		},
+		{
+			"skip_deleted",
+			&dns.RecordSetsResponse{
+				RrSets: &[]dns.RecordSet{
+					{Id: utils.Ptr("rid-1"), Name: utils.Ptr("www.example.com."), Type: utils.Ptr("A"), State: utils.Ptr(dns.DeleteSuccess)},
+					{Id: utils.Ptr("rid-2"), Name: utils.Ptr("www.example.com."), Type: utils.Ptr("A"), State: utils.Ptr(dns.CreateSuccess)},
+				},
+			},
+			"www.example.com.",
+			"A",
+			"rid-2",
+			true,
+		},
+		{
+			"no_match",
+			&dns.RecordSetsResponse{
+				RrSets: &[]dns.RecordSet{
+					{Id: utils.Ptr("rid-1"), Name: utils.Ptr("mail.example.com."), Type: utils.Ptr("A")},
+				},
+			},
+			"www.example.com",
+			"A",
+			"",
+			false,
+		},
+		{
+			"multiple_matches",
+			&dns.RecordSetsResponse{
+				RrSets: &[]dns.RecordSet{
+					{Id: utils.Ptr("rid-1"), Name: utils.Ptr("www.example.com."), Type: utils.Ptr("A")},
+					{Id: utils.Ptr("rid-2"), Name: utils.Ptr("www.example.com."), Type: utils.Ptr("A")},
+				},
+			},
+			"www.example.com",
+			"A",
+			"",
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			"www.example.com",
+			"A",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := selectRecordSetId(tt.input, tt.name, tt.recordType)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestIsDeleted(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *dns.RecordSetResponse
+		expected    bool
+	}{
+		{"nil_response", nil, false},
+		{"nil_record_set", &dns.RecordSetResponse{}, false},
+		{"no_state", &dns.RecordSetResponse{Rrset: &dns.RecordSet{}}, false},
+		{"created", &dns.RecordSetResponse{Rrset: &dns.RecordSet{State: utils.Ptr(dns.CreateSuccess)}}, false},
+		{"deleted", &dns.RecordSetResponse{Rrset: &dns.RecordSet{State: utils.Ptr(dns.DeleteSuccess)}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := isDeleted(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestCheckCnameConflicts(t *testing.T) {
+	recordSet := func(id, name, recordType string) dns.RecordSet {
+		return dns.RecordSet{Id: utils.Ptr(id), Name: utils.Ptr(name), Type: utils.Ptr(recordType), State: utils.Ptr(dns.CreateSuccess)}
+	}
+	tests := []struct {
+		description string
+		name        string
+		recordType  string
+		recordSetId string
+		existing    []dns.RecordSet
+		isValid     bool
+	}{
+		{
+			"cname_ok",
+			"www.example.com",
+			"CNAME",
+			"",
+			[]dns.RecordSet{},
+			true,
+		},
+		{
+			"cname_at_apex",
+			"Example.com.",
+			"cname",
+			"",
+			[]dns.RecordSet{},
+			false,
+		},
+		{
+			"a_at_apex_ok",
+			"example.com",
+			"A",
+			"",
+			[]dns.RecordSet{recordSet("rid", "example.com.", "MX")},
+			true,
+		},
+		{
+			"cname_with_other_type",
+			"www.example.com",
+			"CNAME",
+			"",
+			[]dns.RecordSet{recordSet("rid", "www.example.com.", "A")},
+			false,
+		},
+		{
+			"other_type_with_cname",
+			"www.example.com",
+			"TXT",
+			"",
+			[]dns.RecordSet{recordSet("rid", "www.example.com.", "CNAME")},
+			false,
+		},
+		{
+			"ignore_itself",
+			"www.example.com",
+			"CNAME",
+			"rid",
+			[]dns.RecordSet{recordSet("rid", "www.example.com.", "A")},
+			true,
+		},
+		{
+			"ignore_deleted_and_other_names",
+			"www.example.com",
+			"CNAME",
+			"",
+			[]dns.RecordSet{
+				{Id: utils.Ptr("rid-deleted"), Name: utils.Ptr("www.example.com."), Type: utils.Ptr("A"), State: utils.Ptr(dns.DeleteSuccess)},
+				recordSet("rid-other", "mail.example.com.", "A"),
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkCnameConflicts(tt.name, tt.recordType, tt.recordSetId, "example.com", tt.existing)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestModifyPlanAPICalls(t *testing.T) {
+	tests := []struct {
+		description   string
+		stateName     *string
+		stateType     *string
+		expectedCalls int
+	}{
+		{"create", nil, nil, 2},
+		{"unchanged", utils.Ptr("www.example.com."), utils.Ptr("A"), 0},
+		{"name_changed", utils.Ptr("old.example.com."), utils.Ptr("A"), 2},
+		{"type_changed", utils.Ptr("www.example.com."), utils.Ptr("AAAA"), 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				if strings.HasSuffix(req.URL.Path, "/rrsets") {
+					_, _ = w.Write([]byte(`{"rrSets":[]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"zone":{"dnsName":"example.com"}}`))
+			}))
+			defer server.Close()
+			client, err := dns.NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("Creating the client: %v", err)
+			}
+
+			ctx := context.Background()
+			r := &recordSetResource{client: client}
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			makeValue := func(name, recordType *string) tftypes.Value {
+				values := map[string]tftypes.Value{}
+				for attrName, attrType := range objectType.AttributeTypes {
+					values[attrName] = tftypes.NewValue(attrType, nil)
+				}
+				values["project_id"] = tftypes.NewValue(tftypes.String, "pid")
+				values["zone_id"] = tftypes.NewValue(tftypes.String, "zid")
+				values["name"] = tftypes.NewValue(tftypes.String, name)
+				values["type"] = tftypes.NewValue(tftypes.String, recordType)
+				return tftypes.NewValue(objectType, values)
+			}
+
+			plan := makeValue(utils.Ptr("www.example.com."), utils.Ptr("A"))
+			state := tftypes.NewValue(objectType, nil)
+			if tt.stateName != nil {
+				state = makeValue(tt.stateName, tt.stateType)
+			}
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Raw: state, Schema: schemaResp.Schema},
+				Plan:  tfsdk.Plan{Raw: plan, Schema: schemaResp.Schema},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Raw: plan.Copy(), Schema: schemaResp.Schema},
+			}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			if calls != tt.expectedCalls {
+				t.Fatalf("Expected %d API calls, got %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestCheckRecordSetStatus(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *dns.RecordSetResponse
+		strict      bool
+		hasWarning  bool
+		hasError    bool
+	}{
+		{"nil_response", nil, false, false, false},
+		{"nil_record_set", &dns.RecordSetResponse{}, false, false, false},
+		{"ok", &dns.RecordSetResponse{Rrset: &dns.RecordSet{State: utils.Ptr(dns.UpdateSuccess), Error: utils.Ptr("")}}, true, false, false},
+		{"failed", &dns.RecordSetResponse{Rrset: &dns.RecordSet{State: utils.Ptr(dns.CreateFail)}}, false, true, false},
+		{"error", &dns.RecordSetResponse{Rrset: &dns.RecordSet{Name: utils.Ptr("example.com."), State: utils.Ptr(dns.UpdateSuccess), Error: utils.Ptr("invalid MX target")}}, false, true, false},
+		{"error_strict", &dns.RecordSetResponse{Rrset: &dns.RecordSet{Name: utils.Ptr("example.com."), State: utils.Ptr(dns.UpdateFail), Error: utils.Ptr("invalid MX target")}}, true, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkRecordSetStatus(context.Background(), &diags, tt.input, tt.strict)
+			if tt.hasError != diags.HasError() {
+				t.Fatalf("Expected error %t, got %v", tt.hasError, diags.Errors())
+			}
+			if tt.hasWarning != (diags.WarningsCount() > 0) {
+				t.Fatalf("Expected warning %t, got %v", tt.hasWarning, diags.Warnings())
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+)
+
+// MXRecord is the structured form of an MX record, an alternative to the `<preference> <exchange>` string in records.
+type MXRecord struct {
+	Priority types.Int64  `tfsdk:"priority"`
+	Exchange types.String `tfsdk:"exchange"`
+}
+
+// Types corresponding to MXRecord
+var mxRecordTypes = map[string]attr.Type{
+	"priority": types.Int64Type,
+	"exchange": types.StringType,
+}
+
+// SRVRecord is the structured form of an SRV record, an alternative to the `<priority> <weight> <port> <target>` string in records.
+type SRVRecord struct {
+	Priority types.Int64  `tfsdk:"priority"`
+	Weight   types.Int64  `tfsdk:"weight"`
+	Port     types.Int64  `tfsdk:"port"`
+	Target   types.String `tfsdk:"target"`
+}
+
+// Types corresponding to SRVRecord
+var srvRecordTypes = map[string]attr.Type{
+	"priority": types.Int64Type,
+	"weight":   types.Int64Type,
+	"port":     types.Int64Type,
+	"target":   types.StringType,
+}
+
+// structuredRecords returns the records in wire format of the configured mx_records or srv_records.
+// The result is null if neither is configured, and unknown if any of their values is unknown.
+func structuredRecords(ctx context.Context, mxRecords, srvRecords types.Set) (types.Set, error) {
+	if mxRecords.IsUnknown() || srvRecords.IsUnknown() {
+		return types.SetUnknown(types.StringType), nil
+	}
+	records := []attr.Value{}
+	switch {
+	case !mxRecords.IsNull():
+		var mx []MXRecord
+		diags := mxRecords.ElementsAs(ctx, &mx, false)
+		if diags.HasError() {
+			return types.SetNull(types.StringType), fmt.Errorf("mapping mx_records: %w", core.DiagsToError(diags))
+		}
+		for _, r := range mx {
+			if r.Priority.IsUnknown() || r.Exchange.IsUnknown() {
+				return types.SetUnknown(types.StringType), nil
+			}
+			records = append(records, types.StringValue(fmt.Sprintf("%d %s", r.Priority.ValueInt64(), r.Exchange.ValueString())))
+		}
+	case !srvRecords.IsNull():
+		var srv []SRVRecord
+		diags := srvRecords.ElementsAs(ctx, &srv, false)
+		if diags.HasError() {
+			return types.SetNull(types.StringType), fmt.Errorf("mapping srv_records: %w", core.DiagsToError(diags))
+		}
+		for _, r := range srv {
+			if r.Priority.IsUnknown() || r.Weight.IsUnknown() || r.Port.IsUnknown() || r.Target.IsUnknown() {
+				return types.SetUnknown(types.StringType), nil
+			}
+			records = append(records, types.StringValue(fmt.Sprintf("%d %d %d %s", r.Priority.ValueInt64(), r.Weight.ValueInt64(), r.Port.ValueInt64(), r.Target.ValueString())))
+		}
+	default:
+		return types.SetNull(types.StringType), nil
+	}
+	recordsSet, diags := types.SetValue(types.StringType, records)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), fmt.Errorf("creating records: %w", core.DiagsToError(diags))
+	}
+	return recordsSet, nil
+}
+
+// mapStructuredRecords refreshes mx_records or srv_records from the records.
+// They are only refreshed if they are configured, as the records are managed through the records attribute otherwise.
+func mapStructuredRecords(model *Model) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if model.MxRecords.IsNull() && model.SrvRecords.IsNull() {
+		return nil
+	}
+	records := []string{}
+	for i, record := range model.Records.Elements() {
+		recordString, ok := record.(types.String)
+		if !ok {
+			return fmt.Errorf("expected record at index %d to be of type %T, got %T", i, types.String{}, record)
+		}
+		records = append(records, recordString.ValueString())
+	}
+
+	if !model.MxRecords.IsNull() {
+		values := []attr.Value{}
+		for _, record := range records {
+			fields, err := parseRecordFields(record, 2)
+			if err != nil {
+				return fmt.Errorf("mapping MX record %q: %w", record, err)
+			}
+			value, diags := types.ObjectValue(mxRecordTypes, map[string]attr.Value{
+				"priority": types.Int64Value(fields.numbers[0]),
+				"exchange": types.StringValue(fields.hostname),
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping MX record %q: %w", record, core.DiagsToError(diags))
+			}
+			values = append(values, value)
+		}
+		mxRecords, diags := types.SetValue(types.ObjectType{AttrTypes: mxRecordTypes}, values)
+		if diags.HasError() {
+			return fmt.Errorf("mapping mx_records: %w", core.DiagsToError(diags))
+		}
+		model.MxRecords = mxRecords
+	}
+	if !model.SrvRecords.IsNull() {
+		values := []attr.Value{}
+		for _, record := range records {
+			fields, err := parseRecordFields(record, 4)
+			if err != nil {
+				return fmt.Errorf("mapping SRV record %q: %w", record, err)
+			}
+			value, diags := types.ObjectValue(srvRecordTypes, map[string]attr.Value{
+				"priority": types.Int64Value(fields.numbers[0]),
+				"weight":   types.Int64Value(fields.numbers[1]),
+				"port":     types.Int64Value(fields.numbers[2]),
+				"target":   types.StringValue(fields.hostname),
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping SRV record %q: %w", record, core.DiagsToError(diags))
+			}
+			values = append(values, value)
+		}
+		srvRecords, diags := types.SetValue(types.ObjectType{AttrTypes: srvRecordTypes}, values)
+		if diags.HasError() {
+			return fmt.Errorf("mapping srv_records: %w", core.DiagsToError(diags))
+		}
+		model.SrvRecords = srvRecords
+	}
+	return nil
+}
+
+type recordFields struct {
+	numbers  []int64
+	hostname string
+}
+
+// parseRecordFields parses a record made of numbers followed by a hostname, e.g. `10 mail.example.com.`
+func parseRecordFields(record string, count int) (*recordFields, error) {
+	fields := strings.Fields(record)
+	if len(fields) != count {
+		return nil, fmt.Errorf("expected %d fields, got %d", count, len(fields))
+	}
+	numbers := []int64{}
+	for _, field := range fields[:count-1] {
+		number, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %w", field, err)
+		}
+		numbers = append(numbers, number)
+	}
+	return &recordFields{
+		numbers:  numbers,
+		hostname: fields[count-1],
+	}, nil
+}
+
+// checkStructuredRecords checks that mx_records and srv_records are only used with record sets of the matching type.
+func checkStructuredRecords(recordType types.String, mxRecords, srvRecords types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if recordType.IsUnknown() {
+		return diags
+	}
+	for _, structured := range []struct {
+		attribute  string
+		value      types.Set
+		recordType string
+	}{
+		{"mx_records", mxRecords, "MX"},
+		{"srv_records", srvRecords, "SRV"},
+	} {
+		if structured.value.IsNull() {
+			continue
+		}
+		if !strings.EqualFold(recordType.ValueString(), structured.recordType) {
+			diags.AddAttributeError(
+				path.Root(structured.attribute),
+				"Invalid record set type",
+				fmt.Sprintf("%s can only be used with record sets of type %q, set the type accordingly. Got type %q", structured.attribute, structured.recordType, recordType.ValueString()),
+			)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,262 @@
+package dns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func mxRecordValue(priority int64, exchange string) attr.Value {
+	return types.ObjectValueMust(mxRecordTypes, map[string]attr.Value{
+		"priority": types.Int64Value(priority),
+		"exchange": types.StringValue(exchange),
+	})
+}
+
+func srvRecordValue(priority, weight, port int64, target string) attr.Value {
+	return types.ObjectValueMust(srvRecordTypes, map[string]attr.Value{
+		"priority": types.Int64Value(priority),
+		"weight":   types.Int64Value(weight),
+		"port":     types.Int64Value(port),
+		"target":   types.StringValue(target),
+	})
+}
+
+var (
+	mxRecordsNull  = types.SetNull(types.ObjectType{AttrTypes: mxRecordTypes})
+	srvRecordsNull = types.SetNull(types.ObjectType{AttrTypes: srvRecordTypes})
+)
+
+func TestStructuredRecords(t *testing.T) {
+	tests := []struct {
+		description string
+		mxRecords   types.Set
+		srvRecords  types.Set
+		expected    types.Set
+	}{
+		{
+			"not_configured",
+			mxRecordsNull,
+			srvRecordsNull,
+			types.SetNull(types.StringType),
+		},
+		{
+			"mx_records",
+			types.SetValueMust(types.ObjectType{AttrTypes: mxRecordTypes}, []attr.Value{
+				mxRecordValue(10, "mail.example.com."),
+				mxRecordValue(20, "backup.example.com"),
+			}),
+			srvRecordsNull,
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("10 mail.example.com."),
+				types.StringValue("20 backup.example.com"),
+			}),
+		},
+		{
+			"srv_records",
+			mxRecordsNull,
+			types.SetValueMust(types.ObjectType{AttrTypes: srvRecordTypes}, []attr.Value{
+				srvRecordValue(10, 5, 5060, "sip.example.com."),
+				srvRecordValue(0, 0, 0, "."),
+			}),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("10 5 5060 sip.example.com."),
+				types.StringValue("0 0 0 ."),
+			}),
+		},
+		{
+			"unknown_set",
+			types.SetUnknown(types.ObjectType{AttrTypes: mxRecordTypes}),
+			srvRecordsNull,
+			types.SetUnknown(types.StringType),
+		},
+		{
+			"unknown_value",
+			types.SetValueMust(types.ObjectType{AttrTypes: mxRecordTypes}, []attr.Value{
+				types.ObjectValueMust(mxRecordTypes, map[string]attr.Value{
+					"priority": types.Int64Value(10),
+					"exchange": types.StringUnknown(),
+				}),
+			}),
+			srvRecordsNull,
+			types.SetUnknown(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := structuredRecords(context.Background(), tt.mxRecords, tt.srvRecords)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapStructuredRecords(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *Model
+		isValid     bool
+	}{
+		{
+			"not_configured",
+			&Model{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("10 mail.example.com."),
+				}),
+				MxRecords:  mxRecordsNull,
+				SrvRecords: srvRecordsNull,
+			},
+			&Model{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("10 mail.example.com."),
+				}),
+				MxRecords:  mxRecordsNull,
+				SrvRecords: srvRecordsNull,
+			},
+			true,
+		},
+		{
+			"mx_records",
+			&Model{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("10 mail.example.com."),
+					types.StringValue("30 other.example.com."),
+				}),
+				MxRecords: types.SetValueMust(types.ObjectType{AttrTypes: mxRecordTypes}, []attr.Value{
+					mxRecordValue(10, "mail.example.com."),
+				}),
+				SrvRecords: srvRecordsNull,
+			},
+			&Model{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("10 mail.example.com."),
+					types.StringValue("30 other.example.com."),
+				}),
+				MxRecords: types.SetValueMust(types.ObjectType{AttrTypes: mxRecordTypes}, []attr.Value{
+					mxRecordValue(10, "mail.example.com."),
+					mxRecordValue(30, "other.example.com."),
+				}),
+				SrvRecords: srvRecordsNull,
+			},
+			true,
+		},
+		{
+			"srv_records",
+			&Model{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("10 5 5060 sip.example.com."),
+				}),
+				MxRecords: mxRecordsNull,
+				SrvRecords: types.SetValueMust(types.ObjectType{AttrTypes: srvRecordTypes}, []attr.Value{
+					srvRecordValue(10, 5, 5061, "sip.example.com."),
+				}),
+			},
+			&Model{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("10 5 5060 sip.example.com."),
+				}),
+				MxRecords: mxRecordsNull,
+				SrvRecords: types.SetValueMust(types.ObjectType{AttrTypes: srvRecordTypes}, []attr.Value{
+					srvRecordValue(10, 5, 5060, "sip.example.com."),
+				}),
+			},
+			true,
+		},
+		{
+			"invalid_mx_record",
+			&Model{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("mail.example.com."),
+				}),
+				MxRecords: types.SetValueMust(types.ObjectType{AttrTypes: mxRecordTypes}, []attr.Value{
+					mxRecordValue(10, "mail.example.com."),
+				}),
+				SrvRecords: srvRecordsNull,
+			},
+			nil,
+			false,
+		},
+		{
+			"invalid_srv_record",
+			&Model{
+				Records: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("10 x 5060 sip.example.com."),
+				}),
+				MxRecords: mxRecordsNull,
+				SrvRecords: types.SetValueMust(types.ObjectType{AttrTypes: srvRecordTypes}, []attr.Value{
+					srvRecordValue(10, 5, 5060, "sip.example.com."),
+				}),
+			},
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapStructuredRecords(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.input, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckStructuredRecords(t *testing.T) {
+	mxRecords := types.SetValueMust(types.ObjectType{AttrTypes: mxRecordTypes}, []attr.Value{
+		mxRecordValue(10, "mail.example.com."),
+	})
+	srvRecords := types.SetValueMust(types.ObjectType{AttrTypes: srvRecordTypes}, []attr.Value{
+		srvRecordValue(10, 5, 5060, "sip.example.com."),
+	})
+	tests := []struct {
+		description string
+		recordType  types.String
+		mxRecords   types.Set
+		srvRecords  types.Set
+		isValid     bool
+	}{
+		{"not_configured", types.StringValue("A"), mxRecordsNull, srvRecordsNull, true},
+		{"mx_records", types.StringValue("MX"), mxRecords, srvRecordsNull, true},
+		{"mx_records_lowercase_type", types.StringValue("mx"), mxRecords, srvRecordsNull, true},
+		{"srv_records", types.StringValue("SRV"), mxRecordsNull, srvRecords, true},
+		{"unknown_type", types.StringUnknown(), mxRecords, srvRecordsNull, true},
+		{"mx_records_wrong_type", types.StringValue("SRV"), mxRecords, srvRecordsNull, false},
+		{"srv_records_wrong_type", types.StringValue("A"), mxRecordsNull, srvRecords, false},
+		{"mx_records_type_not_set", types.StringNull(), mxRecords, srvRecordsNull, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkStructuredRecords(tt.recordType, tt.mxRecords, tt.srvRecords)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// pageSize is the number of record sets requested per page when listing.
+const pageSize = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &recordSetsDataSource{}
+)
+
+type Model struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	ProjectId  types.String `tfsdk:"project_id"`
+	ZoneId     types.String `tfsdk:"zone_id"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Active     types.Bool   `tfsdk:"active"`
+	RecordSets []RecordSet  `tfsdk:"record_sets"`
+}
+
+type RecordSet struct {
+	RecordSetId types.String `tfsdk:"record_set_id"`
+	Name        types.String `tfsdk:"name"`
+	Records     types.Set    `tfsdk:"records"`
+	TTL         types.Int64  `tfsdk:"ttl"`
+	Type        types.String `tfsdk:"type"`
+	Active      types.Bool   `tfsdk:"active"`
+	Comment     types.String `tfsdk:"comment"`
+	State       types.String `tfsdk:"state"`
+}
+
+// NewRecordSetsDataSource is a helper function to simplify the provider implementation.
+func NewRecordSetsDataSource() datasource.DataSource {
+	return &recordSetsDataSource{}
+}
+
+// recordSetsDataSource is the data source implementation.
+type recordSetsDataSource struct {
+	client *dns.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *recordSetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record_sets"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *recordSetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	var apiClient *dns.APIClient
+	var err error
+	if providerData.DnsCustomEndpoint != "" {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+			config.WithEndpoint(providerData.DnsCustomEndpoint),
+		)
+	} else {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DNS record sets client configured")
+	d.client = apiClient
+}
+
+// Schema defines the schema for the data source.
+func (d *recordSetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS record sets data source schema. Lists the record sets of a zone, optionally filtered by name, type and active flag.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`,`zone_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the dns zone is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "The zone ID whose record sets are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "If set, only record sets with exactly this name are returned. E.g. `www.example.com.`",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "If set, only record sets of this type are returned. E.g. `A` or `CNAME`",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "If set, only record sets with this active flag are returned.",
+				Optional:    true,
+			},
+			"record_sets": schema.ListNestedAttribute{
+				Description: "The record sets of the zone matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"record_set_id": schema.StringAttribute{
+							Description: "The rr set id.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the record set.",
+							Computed:    true,
+						},
+						"records": schema.SetAttribute{
+							Description: "Records.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "Time to live.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The record set type.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Specifies if the record set is active or not.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "Comment.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Record set state.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *recordSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state Model
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	state.ZoneId = core.CanonicalizeIDValue(state.ZoneId)
+	projectId := state.ProjectId.ValueString()
+	zoneId := state.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	recordSets := []dns.RecordSet{}
+	for page := int32(1); ; page++ {
+		listReq := d.client.GetRecordSets(ctx, projectId, zoneId).Page(page).PageSize(pageSize)
+		if !state.Name.IsNull() {
+			listReq = listReq.NameEq(state.Name.ValueString())
+		}
+		if !state.Type.IsNull() {
+			listReq = listReq.TypeEq(state.Type.ValueString())
+		}
+		if !state.Active.IsNull() {
+			listReq = listReq.ActiveEq(state.Active.ValueBool())
+		}
+		listResp, err := listReq.Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to list record sets", fmt.Sprintf("Calling API for page %d: %v", page, err))
+			return
+		}
+		if listResp.RrSets != nil {
+			recordSets = append(recordSets, *listResp.RrSets...)
+		}
+		if listResp.TotalPages == nil || page >= *listResp.TotalPages {
+			break
+		}
+	}
+
+	err := mapFields(recordSets, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "DNS record sets read")
+}
+
+func mapFields(recordSets []dns.RecordSet, model *Model) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = types.StringValue(core.JoinID(model.ProjectId.ValueString(), model.ZoneId.ValueString()))
+	model.RecordSets = []RecordSet{}
+	for _, recordSet := range recordSets {
+		if recordSet.Id == nil {
+			return fmt.Errorf("record set id not present")
+		}
+
+		records := types.SetNull(types.StringType)
+		if recordSet.Records != nil {
+			elements := []attr.Value{}
+			for _, record := range *recordSet.Records {
+				elements = append(elements, types.StringPointerValue(record.Content))
+			}
+			recordsSet, setDiags := types.SetValue(types.StringType, elements)
+			if setDiags.HasError() {
+				return fmt.Errorf("failed to map records of record set %s: %w", *recordSet.Id, core.DiagsToError(setDiags))
+			}
+			records = recordsSet
+		}
+
+		model.RecordSets = append(model.RecordSets, RecordSet{
+			RecordSetId: types.StringPointerValue(recordSet.Id),
+			Name:        types.StringPointerValue(recordSet.Name),
+			Records:     records,
+			TTL:         conversion.ToTypeInt64(recordSet.Ttl),
+			Type:        types.StringPointerValue(recordSet.Type),
+			Active:      types.BoolPointerValue(recordSet.Active),
+			Comment:     types.StringPointerValue(recordSet.Comment),
+			State:       types.StringPointerValue(recordSet.State),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []dns.RecordSet
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"no_record_sets",
+			[]dns.RecordSet{},
+			Model{
+				Id:         types.StringValue("pid,zid"),
+				ProjectId:  types.StringValue("pid"),
+				ZoneId:     types.StringValue("zid"),
+				RecordSets: []RecordSet{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			[]dns.RecordSet{
+				{
+					Id:      utils.Ptr("rid-1"),
+					Name:    utils.Ptr("www.example.com."),
+					Records: &[]dns.Record{{Content: utils.Ptr("1.2.3.4")}, {Content: utils.Ptr("5.6.7.8")}},
+					Ttl:     utils.Ptr(int32(3600)),
+					Type:    utils.Ptr("A"),
+					Active:  utils.Ptr(true),
+					Comment: utils.Ptr("comment"),
+					State:   utils.Ptr("CREATE_SUCCEEDED"),
+				},
+				{
+					Id: utils.Ptr("rid-2"),
+				},
+			},
+			Model{
+				Id:        types.StringValue("pid,zid"),
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				RecordSets: []RecordSet{
+					{
+						RecordSetId: types.StringValue("rid-1"),
+						Name:        types.StringValue("www.example.com."),
+						Records: types.SetValueMust(types.StringType, []attr.Value{
+							types.StringValue("1.2.3.4"),
+							types.StringValue("5.6.7.8"),
+						}),
+						TTL:     types.Int64Value(3600),
+						Type:    types.StringValue("A"),
+						Active:  types.BoolValue(true),
+						Comment: types.StringValue("comment"),
+						State:   types.StringValue("CREATE_SUCCEEDED"),
+					},
+					{
+						RecordSetId: types.StringValue("rid-2"),
+						Name:        types.StringNull(),
+						Records:     types.SetNull(types.StringType),
+						TTL:         types.Int64Null(),
+						Type:        types.StringNull(),
+						Active:      types.BoolNull(),
+						Comment:     types.StringNull(),
+						State:       types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_record_set_id",
+			[]dns.RecordSet{
+				{
+					Name: utils.Ptr("www.example.com."),
+				},
+			},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId: tt.expected.ProjectId,
+				ZoneId:    tt.expected.ZoneId,
+			}
+			err := mapFields(tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
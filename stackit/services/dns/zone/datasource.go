@@ -3,9 +3,12 @@ package dns
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,6 +23,32 @@ var (
 	_ datasource.DataSource = &zoneDataSource{}
 )
 
+// DataSourceModel is the data source model. create_default_records and wait_for_nameservers only control the creation of the zone, so the data source doesn't have them.
+type DataSourceModel struct {
+	Id                types.String `tfsdk:"id"` // needed by TF
+	ZoneId            types.String `tfsdk:"zone_id"`
+	ProjectId         types.String `tfsdk:"project_id"`
+	Name              types.String `tfsdk:"name"`
+	DnsName           types.String `tfsdk:"dns_name"`
+	Description       types.String `tfsdk:"description"`
+	Acl               types.String `tfsdk:"acl"`
+	Active            types.Bool   `tfsdk:"active"`
+	ContactEmail      types.String `tfsdk:"contact_email"`
+	DefaultTTL        types.Int64  `tfsdk:"default_ttl"`
+	ExpireTime        types.Int64  `tfsdk:"expire_time"`
+	IsReverseZone     types.Bool   `tfsdk:"is_reverse_zone"`
+	NegativeCache     types.Int64  `tfsdk:"negative_cache"`
+	PrimaryNameServer types.String `tfsdk:"primary_name_server"`
+	Primaries         types.List   `tfsdk:"primaries"`
+	RecordCount       types.Int64  `tfsdk:"record_count"`
+	RefreshTime       types.Int64  `tfsdk:"refresh_time"`
+	RetryTime         types.Int64  `tfsdk:"retry_time"`
+	SerialNumber      types.Int64  `tfsdk:"serial_number"`
+	Type              types.String `tfsdk:"type"`
+	Visibility        types.String `tfsdk:"visibility"`
+	State             types.String `tfsdk:"state"`
+}
+
 // NewZoneDataSource is a helper function to simplify the provider implementation.
 func NewZoneDataSource() datasource.DataSource {
 	return &zoneDataSource{}
@@ -52,12 +81,12 @@ func (d *zoneDataSource) Configure(ctx context.Context, req datasource.Configure
 
 	if providerData.DnsCustomEndpoint != "" {
 		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
 			config.WithEndpoint(providerData.DnsCustomEndpoint),
 		)
 	} else {
 		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
 		)
 	}
 	if err != nil {
@@ -85,16 +114,18 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "STACKIT project ID to which the dns zone is associated.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
 			"zone_id": schema.StringAttribute{
-				Description: "The zone ID.",
-				Required:    true,
+				Description: "The zone ID. Either `zone_id` or `dns_name` must be set.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("dns_name")),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -102,15 +133,19 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 			},
 			"dns_name": schema.StringAttribute{
-				Description: "The zone name. E.g. `example.com`",
+				Description: "The zone name. E.g. `example.com`. Either `zone_id` or `dns_name` must be set; if `dns_name` is set, the zone is looked up by it within the project.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the zone.",
 				Computed:    true,
 			},
 			"acl": schema.StringAttribute{
-				Description: "The access control list.",
+				Description: "The access control list, a comma-separated list of networks in CIDR notation that are allowed to transfer the zone (AXFR).",
 				Computed:    true,
 			},
 			"active": schema.BoolAttribute{
@@ -134,7 +169,7 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 			},
 			"negative_cache": schema.Int64Attribute{
-				Description: "Negative caching.",
+				Description: "Negative caching, i.e. the time in seconds for which resolvers cache the non-existence of a record (SOA minimum).",
 				Computed:    true,
 			},
 			"primary_name_server": schema.StringAttribute{
@@ -142,7 +177,7 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 			},
 			"primaries": schema.ListAttribute{
-				Description: `Primary name server for secondary zone.`,
+				Description: `Primary name servers (IP addresses) from which a secondary zone is transferred.`,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
@@ -159,11 +194,11 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 			},
 			"serial_number": schema.Int64Attribute{
-				Description: "Serial number.",
+				Description: "Serial number of the zone's SOA record. It is incremented by the API on every change of the zone and can be compared against the serial served by the name servers to track propagation.",
 				Computed:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "Zone type.",
+				Description: "Zone type. E.g. `primary` or `secondary`.",
 				Computed:    true,
 			},
 			"visibility": schema.StringAttribute{
@@ -180,28 +215,54 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (d *zoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var state Model
+	var state DataSourceModel
 	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	state.ZoneId = core.CanonicalizeIDValue(state.ZoneId)
 	projectId := state.ProjectId.ValueString()
-	zoneId := state.ZoneId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
-	ctx = tflog.SetField(ctx, "zone_id", zoneId)
 
-	zoneResp, err := d.client.GetZone(ctx, projectId, zoneId).Execute()
-	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to Read Zone", err.Error())
-		return
+	var zoneResp *dns.ZoneResponse
+	var err error
+	if !state.ZoneId.IsNull() {
+		zoneId := state.ZoneId.ValueString()
+		ctx = tflog.SetField(ctx, "zone_id", zoneId)
+		zoneResp, err = d.client.GetZone(ctx, projectId, zoneId).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to Read Zone", err.Error())
+			return
+		}
+	} else {
+		dnsName := state.DnsName.ValueString()
+		ctx = tflog.SetField(ctx, "dns_name", dnsName)
+		zonesResp, err := d.client.GetZones(ctx, projectId).DnsNameLike(strings.TrimSuffix(dnsName, ".")).StateNeq(dns.DeleteSuccess).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to Read Zone", fmt.Sprintf("Listing zones: %v", err))
+			return
+		}
+		zone, err := selectZoneByDnsName(zonesResp, dnsName)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to Read Zone", err.Error())
+			return
+		}
+		zoneResp = &dns.ZoneResponse{Zone: zone}
 	}
 
-	err = mapFields(zoneResp, &state)
+	model := Model{
+		ProjectId: state.ProjectId,
+		ZoneId:    state.ZoneId,
+	}
+	err = mapFields(zoneResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
 		return
 	}
+	state = toDataSourceModel(&model)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -209,3 +270,62 @@ func (d *zoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 	tflog.Info(ctx, "DNS zone read")
 }
+
+// selectZoneByDnsName returns the single zone of the list whose DNS name matches dnsName.
+// The comparison ignores case and a trailing dot, and deleted zones are skipped.
+func selectZoneByDnsName(zonesResp *dns.ZonesResponse, dnsName string) (*dns.Zone, error) {
+	if zonesResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+	normalize := func(name string) string {
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	}
+
+	var match *dns.Zone
+	if zonesResp.Zones != nil {
+		for i := range *zonesResp.Zones {
+			z := &(*zonesResp.Zones)[i]
+			if z.DnsName == nil || normalize(*z.DnsName) != normalize(dnsName) {
+				continue
+			}
+			if z.State != nil && *z.State == dns.DeleteSuccess {
+				continue
+			}
+			if match != nil {
+				return nil, fmt.Errorf("found more than one zone with DNS name %q, use zone_id instead", dnsName)
+			}
+			match = z
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("no zone with DNS name %q found in the project", dnsName)
+	}
+	return match, nil
+}
+
+func toDataSourceModel(model *Model) DataSourceModel {
+	return DataSourceModel{
+		Id:                model.Id,
+		ZoneId:            model.ZoneId,
+		ProjectId:         model.ProjectId,
+		Name:              model.Name,
+		DnsName:           model.DnsName,
+		Description:       model.Description,
+		Acl:               model.Acl,
+		Active:            model.Active,
+		ContactEmail:      model.ContactEmail,
+		DefaultTTL:        model.DefaultTTL,
+		ExpireTime:        model.ExpireTime,
+		IsReverseZone:     model.IsReverseZone,
+		NegativeCache:     model.NegativeCache,
+		PrimaryNameServer: model.PrimaryNameServer,
+		Primaries:         model.Primaries,
+		RecordCount:       model.RecordCount,
+		RefreshTime:       model.RefreshTime,
+		RetryTime:         model.RetryTime,
+		SerialNumber:      model.SerialNumber,
+		Type:              model.Type,
+		Visibility:        model.Visibility,
+		State:             model.State,
+	}
+}
@@ -0,0 +1,114 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/testutil/modeltest"
+)
+
+func TestSelectZoneByDnsName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *dns.ZonesResponse
+		dnsName     string
+		expected    *dns.Zone
+		isValid     bool
+	}{
+		{
+			"exact_match",
+			&dns.ZonesResponse{
+				Zones: &[]dns.Zone{
+					{Id: utils.Ptr("zid-1"), DnsName: utils.Ptr("sub.example.com")},
+					{Id: utils.Ptr("zid-2"), DnsName: utils.Ptr("example.com")},
+				},
+			},
+			"example.com",
+			&dns.Zone{Id: utils.Ptr("zid-2"), DnsName: utils.Ptr("example.com")},
+			true,
+		},
+		{
+			"trailing_dot_and_case",
+			&dns.ZonesResponse{
+				Zones: &[]dns.Zone{
+					{Id: utils.Ptr("zid"), DnsName: utils.Ptr("Example.com")},
+				},
+			},
+			"example.com.",
+			&dns.Zone{Id: utils.Ptr("zid"), DnsName: utils.Ptr("Example.com")},
+			true,
+		},
+		{
+			"deleted_zone_skipped",
+			&dns.ZonesResponse{
+				Zones: &[]dns.Zone{
+					{Id: utils.Ptr("zid-1"), DnsName: utils.Ptr("example.com"), State: utils.Ptr(dns.DeleteSuccess)},
+					{Id: utils.Ptr("zid-2"), DnsName: utils.Ptr("example.com"), State: utils.Ptr(dns.CreateSuccess)},
+				},
+			},
+			"example.com",
+			&dns.Zone{Id: utils.Ptr("zid-2"), DnsName: utils.Ptr("example.com"), State: utils.Ptr(dns.CreateSuccess)},
+			true,
+		},
+		{
+			"not_found",
+			&dns.ZonesResponse{
+				Zones: &[]dns.Zone{
+					{Id: utils.Ptr("zid"), DnsName: utils.Ptr("sub.example.com")},
+				},
+			},
+			"example.com",
+			nil,
+			false,
+		},
+		{
+			"multiple_matches",
+			&dns.ZonesResponse{
+				Zones: &[]dns.Zone{
+					{Id: utils.Ptr("zid-1"), DnsName: utils.Ptr("example.com")},
+					{Id: utils.Ptr("zid-2"), DnsName: utils.Ptr("example.com")},
+				},
+			},
+			"example.com",
+			nil,
+			false,
+		},
+		{
+			"no_zones",
+			&dns.ZonesResponse{},
+			"example.com",
+			nil,
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			"example.com",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := selectZoneByDnsName(tt.input, tt.dnsName)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToDataSourceModel(t *testing.T) {
+	modeltest.CheckDataSourceModel(t, toDataSourceModel, "create_default_records", "wait_for_nameservers")
+}
@@ -0,0 +1,300 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+)
+
+// defaultRecordsWaitTimeout is the timeout of the wait handler of a single default record set operation,
+// unless the provider configures a default timeout for the operation.
+const defaultRecordsWaitTimeout = 1 * time.Minute
+
+// DefaultRecords are the bootstrap record sets that are managed together with the zone.
+type DefaultRecords struct {
+	ApexA    types.List `tfsdk:"apex_a"`
+	WwwCname types.Bool `tfsdk:"www_cname"`
+}
+
+// Types corresponding to DefaultRecords
+var defaultRecordsTypes = map[string]attr.Type{
+	"apex_a":    basetypes.ListType{ElemType: types.StringType},
+	"www_cname": basetypes.BoolType{},
+}
+
+// defaultRecordSet is one of the record sets managed by create_default_records.
+// An empty list of records means that the record set shouldn't exist.
+type defaultRecordSet struct {
+	name       string
+	recordType string
+	records    []string
+}
+
+func (s *defaultRecordSet) key() string {
+	return s.name + " " + s.recordType
+}
+
+// defaultRecordSetNames returns the fully qualified names of the zone apex and of its www record.
+func defaultRecordSetNames(dnsName string) (apex, www string) {
+	apex = strings.ToLower(dnsName)
+	if !strings.HasSuffix(apex, ".") {
+		apex += "."
+	}
+	return apex, "www." + apex
+}
+
+// toDefaultRecordSets returns the desired apex A and www CNAME record sets of the zone with the given DNS name.
+// If the default records aren't configured, both record sets are returned without records.
+func toDefaultRecordSets(ctx context.Context, defaultRecords types.Object, dnsName string) ([]defaultRecordSet, error) {
+	apex, www := defaultRecordSetNames(dnsName)
+	apexA := defaultRecordSet{name: apex, recordType: "A", records: []string{}}
+	wwwCname := defaultRecordSet{name: www, recordType: "CNAME", records: []string{}}
+
+	if !defaultRecords.IsNull() && !defaultRecords.IsUnknown() {
+		var model DefaultRecords
+		diags := defaultRecords.As(ctx, &model, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping create_default_records: %w", core.DiagsToError(diags))
+		}
+		for i, record := range model.ApexA.Elements() {
+			recordString, ok := record.(types.String)
+			if !ok {
+				return nil, fmt.Errorf("apex_a element %d: type assertion failed", i)
+			}
+			apexA.records = append(apexA.records, recordString.ValueString())
+		}
+		if model.WwwCname.ValueBool() {
+			wwwCname.records = []string{apex}
+		}
+	}
+	return []defaultRecordSet{apexA, wwwCname}, nil
+}
+
+// mapDefaultRecords refreshes create_default_records from the existing apex A and www CNAME records.
+// The block is only refreshed if it is configured, and within it only the configured record sets,
+// as the zone doesn't manage these record sets otherwise.
+func mapDefaultRecords(ctx context.Context, apexA, wwwCname []string, dnsName string, model *Model) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if model.CreateDefaultRecords.IsNull() || model.CreateDefaultRecords.IsUnknown() {
+		return nil
+	}
+	var defaultRecords DefaultRecords
+	diags := model.CreateDefaultRecords.As(ctx, &defaultRecords, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return fmt.Errorf("mapping create_default_records: %w", core.DiagsToError(diags))
+	}
+
+	current, err := toDefaultRecordSets(ctx, model.CreateDefaultRecords, dnsName)
+	if err != nil {
+		return err
+	}
+	// Record sets that aren't configured aren't managed by the zone, e.g. they are managed by a stackit_dns_record_set.
+	// They are left out of the state, as they would be deleted on the next update otherwise
+	switch {
+	case defaultRecords.ApexA.IsNull():
+		// Not managed by the zone
+	case equalRecords(current[0].records, apexA):
+		// Keep the configured order
+	default:
+		apexARecords := []attr.Value{}
+		for _, record := range apexA {
+			apexARecords = append(apexARecords, types.StringValue(record))
+		}
+		apexAList, diags := types.ListValue(types.StringType, apexARecords)
+		if diags.HasError() {
+			return fmt.Errorf("creating apex_a list: %w", core.DiagsToError(diags))
+		}
+		defaultRecords.ApexA = apexAList
+	}
+	apex, _ := defaultRecordSetNames(dnsName)
+	if defaultRecords.WwwCname.ValueBool() {
+		defaultRecords.WwwCname = types.BoolValue(equalRecords([]string{apex}, wwwCname))
+	}
+
+	defaultRecordsObject, diags := types.ObjectValueFrom(ctx, defaultRecordsTypes, defaultRecords)
+	if diags.HasError() {
+		return fmt.Errorf("creating create_default_records: %w", core.DiagsToError(diags))
+	}
+	model.CreateDefaultRecords = defaultRecordsObject
+	return nil
+}
+
+// equalRecords compares two lists of records regardless of order and case, ignoring a trailing dot.
+func equalRecords(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := map[string]int{}
+	for _, r := range a {
+		count[normalizeRecord(r)]++
+	}
+	for _, r := range b {
+		count[normalizeRecord(r)]--
+	}
+	for _, c := range count {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeRecord(record string) string {
+	return strings.TrimSuffix(strings.ToLower(record), ".")
+}
+
+// readDefaultRecords returns the records of the existing apex A and www CNAME record sets of the zone.
+func readDefaultRecords(ctx context.Context, client *dns.APIClient, projectId, zoneId, dnsName string) (apexA, wwwCname []string, err error) {
+	recordSets, err := toDefaultRecordSets(ctx, types.ObjectNull(defaultRecordsTypes), dnsName)
+	if err != nil {
+		return nil, nil, err
+	}
+	records := make([][]string, len(recordSets))
+	for i := range recordSets {
+		existing, err := getDefaultRecordSet(ctx, client, projectId, zoneId, &recordSets[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		records[i] = existingRecords(existing)
+	}
+	return records[0], records[1], nil
+}
+
+// syncDefaultRecords brings the apex A and www CNAME record sets of the zone to the desired state.
+// Record sets that aren't desired anymore are only deleted if they were desired before, i.e. managed by the zone.
+func syncDefaultRecords(ctx context.Context, client *dns.APIClient, timeouts core.Timeouts, projectId, zoneId string, desired, previous []defaultRecordSet) error {
+	for i := range desired {
+		s := &desired[i]
+		managed := i < len(previous) && len(previous[i].records) > 0
+		if len(s.records) == 0 && !managed {
+			continue
+		}
+		existing, err := getDefaultRecordSet(ctx, client, projectId, zoneId, s)
+		if err != nil {
+			return err
+		}
+		switch {
+		case len(s.records) == 0:
+			if existing != nil {
+				err = deleteDefaultRecordSet(ctx, client, timeouts, projectId, zoneId, *existing.Id, s)
+			}
+		case existing == nil:
+			err = createDefaultRecordSet(ctx, client, timeouts, projectId, zoneId, s)
+		case !equalRecords(s.records, existingRecords(existing)):
+			err = updateDefaultRecordSet(ctx, client, timeouts, projectId, zoneId, *existing.Id, s)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDefaultRecordSet returns the existing record set with the name and type of recordSet, or nil if there is none.
+func getDefaultRecordSet(ctx context.Context, client *dns.APIClient, projectId, zoneId string, recordSet *defaultRecordSet) (*dns.RecordSet, error) {
+	listResp, err := client.GetRecordSets(ctx, projectId, zoneId).NameEq(recordSet.name).TypeEq(recordSet.recordType).StateNeq(dns.DeleteSuccess).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("reading record set %s: %w", recordSet.key(), err)
+	}
+	if listResp.RrSets == nil {
+		return nil, nil
+	}
+	for i := range *listResp.RrSets {
+		existing := &(*listResp.RrSets)[i]
+		if existing.Id != nil && existing.Name != nil && strings.EqualFold(*existing.Name, recordSet.name) {
+			return existing, nil
+		}
+	}
+	return nil, nil
+}
+
+func existingRecords(recordSet *dns.RecordSet) []string {
+	records := []string{}
+	if recordSet == nil || recordSet.Records == nil {
+		return records
+	}
+	for _, record := range *recordSet.Records {
+		if record.Content != nil {
+			records = append(records, *record.Content)
+		}
+	}
+	return records
+}
+
+func createDefaultRecordSet(ctx context.Context, client *dns.APIClient, timeouts core.Timeouts, projectId, zoneId string, recordSet *defaultRecordSet) error {
+	var recordSetResp *dns.RecordSetResponse
+	err := core.RetryOnConflict(ctx, func() error {
+		var err error
+		recordSetResp, err = client.CreateRecordSet(ctx, projectId, zoneId).CreateRecordSetPayload(dns.CreateRecordSetPayload{
+			Name:    utils.Ptr(recordSet.name),
+			Type:    utils.Ptr(recordSet.recordType),
+			Records: toRecordPayloads(recordSet.records),
+		}).Execute()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("creating record set %s: %w", recordSet.key(), err)
+	}
+	if recordSetResp.Rrset == nil || recordSetResp.Rrset.Id == nil {
+		return fmt.Errorf("creating record set %s: response has no record set id", recordSet.key())
+	}
+	recordSetId := *recordSetResp.Rrset.Id
+	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
+	_, err = dns.CreateRecordSetWaitHandler(ctx, client, projectId, zoneId, recordSetId).SetTimeout(timeouts.CreateOr(defaultRecordsWaitTimeout)).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("creating record set %s: waiting: %w", recordSet.key(), core.WaitError(ctx, err))
+	}
+	return nil
+}
+
+func updateDefaultRecordSet(ctx context.Context, client *dns.APIClient, timeouts core.Timeouts, projectId, zoneId, recordSetId string, recordSet *defaultRecordSet) error {
+	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
+	err := core.RetryOnConflict(ctx, func() error {
+		_, err := client.UpdateRecordSet(ctx, projectId, zoneId, recordSetId).UpdateRecordSetPayload(dns.UpdateRecordSetPayload{
+			Records: toRecordPayloads(recordSet.records),
+		}).Execute()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("updating record set %s: %w", recordSet.key(), err)
+	}
+	_, err = dns.UpdateRecordSetWaitHandler(ctx, client, projectId, zoneId, recordSetId).SetTimeout(timeouts.UpdateOr(defaultRecordsWaitTimeout)).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("updating record set %s: waiting: %w", recordSet.key(), core.WaitError(ctx, err))
+	}
+	return nil
+}
+
+func deleteDefaultRecordSet(ctx context.Context, client *dns.APIClient, timeouts core.Timeouts, projectId, zoneId, recordSetId string, recordSet *defaultRecordSet) error {
+	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
+	_, err := client.DeleteRecordSet(ctx, projectId, zoneId, recordSetId).Execute()
+	if err != nil {
+		return fmt.Errorf("deleting record set %s: %w", recordSet.key(), err)
+	}
+	_, err = dns.DeleteRecordSetWaitHandler(ctx, client, projectId, zoneId, recordSetId).SetTimeout(timeouts.DeleteOr(defaultRecordsWaitTimeout)).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting record set %s: waiting: %w", recordSet.key(), core.WaitError(ctx, err))
+	}
+	return nil
+}
+
+func toRecordPayloads(records []string) *[]dns.RecordPayload {
+	payloads := []dns.RecordPayload{}
+	for _, record := range records {
+		payloads = append(payloads, dns.RecordPayload{
+			Content: utils.Ptr(record),
+		})
+	}
+	return &payloads
+}
@@ -0,0 +1,154 @@
+package dns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func defaultRecordsValue(apexA []string, wwwCname bool) types.Object {
+	apexAList := types.ListNull(types.StringType)
+	if apexA != nil {
+		elements := []attr.Value{}
+		for _, record := range apexA {
+			elements = append(elements, types.StringValue(record))
+		}
+		apexAList = types.ListValueMust(types.StringType, elements)
+	}
+	return types.ObjectValueMust(defaultRecordsTypes, map[string]attr.Value{
+		"apex_a":    apexAList,
+		"www_cname": types.BoolValue(wwwCname),
+	})
+}
+
+func TestToDefaultRecordSets(t *testing.T) {
+	tests := []struct {
+		description    string
+		defaultRecords types.Object
+		dnsName        string
+		expected       []defaultRecordSet
+	}{
+		{
+			description:    "not_configured",
+			defaultRecords: types.ObjectNull(defaultRecordsTypes),
+			dnsName:        "example.com",
+			expected: []defaultRecordSet{
+				{name: "example.com.", recordType: "A", records: []string{}},
+				{name: "www.example.com.", recordType: "CNAME", records: []string{}},
+			},
+		},
+		{
+			description:    "all_records",
+			defaultRecords: defaultRecordsValue([]string{"1.2.3.4", "5.6.7.8"}, true),
+			dnsName:        "Example.com.",
+			expected: []defaultRecordSet{
+				{name: "example.com.", recordType: "A", records: []string{"1.2.3.4", "5.6.7.8"}},
+				{name: "www.example.com.", recordType: "CNAME", records: []string{"example.com."}},
+			},
+		},
+		{
+			description:    "only_www",
+			defaultRecords: defaultRecordsValue(nil, true),
+			dnsName:        "example.com",
+			expected: []defaultRecordSet{
+				{name: "example.com.", recordType: "A", records: []string{}},
+				{name: "www.example.com.", recordType: "CNAME", records: []string{"example.com."}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toDefaultRecordSets(context.Background(), tt.defaultRecords, tt.dnsName)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected, cmp.AllowUnexported(defaultRecordSet{}))
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapDefaultRecords(t *testing.T) {
+	tests := []struct {
+		description string
+		state       types.Object
+		apexA       []string
+		wwwCname    []string
+		expected    types.Object
+	}{
+		{
+			description: "not_configured",
+			state:       types.ObjectNull(defaultRecordsTypes),
+			apexA:       []string{"1.2.3.4"},
+			wwwCname:    []string{"example.com."},
+			expected:    types.ObjectNull(defaultRecordsTypes),
+		},
+		{
+			description: "unchanged",
+			state:       defaultRecordsValue([]string{"5.6.7.8", "1.2.3.4"}, true),
+			apexA:       []string{"1.2.3.4", "5.6.7.8"},
+			wwwCname:    []string{"Example.com"},
+			expected:    defaultRecordsValue([]string{"5.6.7.8", "1.2.3.4"}, true),
+		},
+		{
+			description: "apex_a_changed",
+			state:       defaultRecordsValue([]string{"1.2.3.4"}, false),
+			apexA:       []string{"1.2.3.4", "5.6.7.8"},
+			wwwCname:    []string{},
+			expected:    defaultRecordsValue([]string{"1.2.3.4", "5.6.7.8"}, false),
+		},
+		{
+			description: "records_deleted",
+			state:       defaultRecordsValue([]string{"1.2.3.4"}, true),
+			apexA:       []string{},
+			wwwCname:    []string{},
+			expected:    defaultRecordsValue([]string{}, false),
+		},
+		{
+			description: "apex_a_not_configured",
+			state:       defaultRecordsValue(nil, true),
+			apexA:       []string{},
+			wwwCname:    []string{"example.com."},
+			expected:    defaultRecordsValue(nil, true),
+		},
+		{
+			description: "www_pointing_elsewhere",
+			state:       defaultRecordsValue(nil, true),
+			apexA:       []string{},
+			wwwCname:    []string{"example.org."},
+			expected:    defaultRecordsValue(nil, false),
+		},
+		{
+			description: "foreign_apex_a",
+			state:       defaultRecordsValue(nil, true),
+			apexA:       []string{"1.2.3.4"},
+			wwwCname:    []string{"example.com."},
+			expected:    defaultRecordsValue(nil, true),
+		},
+		{
+			description: "foreign_www_cname",
+			state:       defaultRecordsValue([]string{"1.2.3.4"}, false),
+			apexA:       []string{"1.2.3.4"},
+			wwwCname:    []string{"example.com."},
+			expected:    defaultRecordsValue([]string{"1.2.3.4"}, false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{CreateDefaultRecords: tt.state}
+			err := mapDefaultRecords(context.Background(), tt.apexA, tt.wwwCname, "example.com", model)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(model.CreateDefaultRecords, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+)
+
+// nameserversWaitTimeout is the maximum time to wait for the delegation of a new zone to be visible in public DNS,
+// unless the provider configures a default timeout for creations.
+const nameserversWaitTimeout = 30 * time.Minute
+
+// lookupNSFunc looks up the NS records of a domain, e.g. net.DefaultResolver.LookupNS.
+type lookupNSFunc func(ctx context.Context, name string) ([]*net.NS, error)
+
+// isDelegated checks if the NS records of dnsName, as seen by the resolver, contain the primary name server of the zone.
+// Lookup errors that just mean the name isn't resolvable yet are not returned as errors.
+func isDelegated(ctx context.Context, lookupNS lookupNSFunc, dnsName, primaryNameServer string) (bool, error) {
+	nameServers, err := lookupNS(ctx, dnsName)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && (dnsErr.IsNotFound || dnsErr.IsTemporary || dnsErr.IsTimeout) {
+			return false, nil
+		}
+		return false, fmt.Errorf("looking up NS records of %s: %w", dnsName, err)
+	}
+	expected := strings.ToLower(strings.TrimSuffix(primaryNameServer, "."))
+	for _, ns := range nameServers {
+		if strings.ToLower(strings.TrimSuffix(ns.Host, ".")) == expected {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// waitForNameservers polls the resolver until the zone is delegated to its primary name server, at most for the given timeout.
+func waitForNameservers(ctx context.Context, lookupNS lookupNSFunc, dnsName, primaryNameServer string, timeout time.Duration) error {
+	if primaryNameServer == "" {
+		return fmt.Errorf("the zone has no primary name server")
+	}
+	handler := wait.New(func() (res interface{}, done bool, err error) {
+		delegated, err := isDelegated(ctx, lookupNS, dnsName, primaryNameServer)
+		if err != nil {
+			return nil, false, err
+		}
+		if !delegated {
+			tflog.Debug(ctx, fmt.Sprintf("Zone %s is not delegated to %s yet", dnsName, primaryNameServer))
+		}
+		return nil, delegated, nil
+	})
+	err := handler.SetThrottle(15 * time.Second)
+	if err != nil {
+		return err
+	}
+	_, err = handler.SetTimeout(timeout).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("zone %s is not delegated to %s: %w", dnsName, primaryNameServer, err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
+
+func fixtureLookupNS(hosts []string, err error) lookupNSFunc {
+	return func(_ context.Context, _ string) ([]*net.NS, error) {
+		if err != nil {
+			return nil, err
+		}
+		nameServers := []*net.NS{}
+		for _, h := range hosts {
+			nameServers = append(nameServers, &net.NS{Host: h})
+		}
+		return nameServers, nil
+	}
+}
+
+func TestIsDelegated(t *testing.T) {
+	tests := []struct {
+		description string
+		lookupNS    lookupNSFunc
+		expected    bool
+		isValid     bool
+	}{
+		{
+			"delegated",
+			fixtureLookupNS([]string{"ns2.example.cloud.", "NS1.example.cloud."}, nil),
+			true,
+			true,
+		},
+		{
+			"other_name_servers",
+			fixtureLookupNS([]string{"ns1.other.net."}, nil),
+			false,
+			true,
+		},
+		{
+			"not_found",
+			fixtureLookupNS(nil, &net.DNSError{Err: "no such host", Name: "example.com", IsNotFound: true}),
+			false,
+			true,
+		},
+		{
+			"timeout",
+			fixtureLookupNS(nil, &net.DNSError{Err: "i/o timeout", Name: "example.com", IsTimeout: true}),
+			false,
+			true,
+		},
+		{
+			"other_error",
+			fixtureLookupNS(nil, fmt.Errorf("error")),
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := isDelegated(context.Background(), tt.lookupNS, "example.com", "ns1.example.cloud")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestWaitForNameservers(t *testing.T) {
+	err := waitForNameservers(context.Background(), fixtureLookupNS([]string{"ns1.example.cloud."}, nil), "example.com", "ns1.example.cloud", time.Minute)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	err = waitForNameservers(context.Background(), fixtureLookupNS([]string{"ns1.example.cloud."}, nil), "example.com", "", time.Minute)
+	if err == nil {
+		t.Fatalf("Should have failed without primary name server")
+	}
+}
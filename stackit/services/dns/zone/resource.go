@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net"
 	"strings"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,36 +30,43 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 )
 
+// serviceEnablementHint describes how DNS is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate DNS for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &zoneResource{}
-	_ resource.ResourceWithConfigure   = &zoneResource{}
-	_ resource.ResourceWithImportState = &zoneResource{}
+	_ resource.Resource                   = &zoneResource{}
+	_ resource.ResourceWithConfigure      = &zoneResource{}
+	_ resource.ResourceWithImportState    = &zoneResource{}
+	_ resource.ResourceWithValidateConfig = &zoneResource{}
+	_ resource.ResourceWithModifyPlan     = &zoneResource{}
 )
 
 type Model struct {
-	Id                types.String `tfsdk:"id"` // needed by TF
-	ZoneId            types.String `tfsdk:"zone_id"`
-	ProjectId         types.String `tfsdk:"project_id"`
-	Name              types.String `tfsdk:"name"`
-	DnsName           types.String `tfsdk:"dns_name"`
-	Description       types.String `tfsdk:"description"`
-	Acl               types.String `tfsdk:"acl"`
-	Active            types.Bool   `tfsdk:"active"`
-	ContactEmail      types.String `tfsdk:"contact_email"`
-	DefaultTTL        types.Int64  `tfsdk:"default_ttl"`
-	ExpireTime        types.Int64  `tfsdk:"expire_time"`
-	IsReverseZone     types.Bool   `tfsdk:"is_reverse_zone"`
-	NegativeCache     types.Int64  `tfsdk:"negative_cache"`
-	PrimaryNameServer types.String `tfsdk:"primary_name_server"`
-	Primaries         types.List   `tfsdk:"primaries"`
-	RecordCount       types.Int64  `tfsdk:"record_count"`
-	RefreshTime       types.Int64  `tfsdk:"refresh_time"`
-	RetryTime         types.Int64  `tfsdk:"retry_time"`
-	SerialNumber      types.Int64  `tfsdk:"serial_number"`
-	Type              types.String `tfsdk:"type"`
-	Visibility        types.String `tfsdk:"visibility"`
-	State             types.String `tfsdk:"state"`
+	Id                   types.String `tfsdk:"id"` // needed by TF
+	ZoneId               types.String `tfsdk:"zone_id"`
+	ProjectId            types.String `tfsdk:"project_id"`
+	Name                 types.String `tfsdk:"name"`
+	DnsName              types.String `tfsdk:"dns_name"`
+	Description          types.String `tfsdk:"description"`
+	Acl                  types.String `tfsdk:"acl"`
+	Active               types.Bool   `tfsdk:"active"`
+	ContactEmail         types.String `tfsdk:"contact_email"`
+	DefaultTTL           types.Int64  `tfsdk:"default_ttl"`
+	ExpireTime           types.Int64  `tfsdk:"expire_time"`
+	IsReverseZone        types.Bool   `tfsdk:"is_reverse_zone"`
+	NegativeCache        types.Int64  `tfsdk:"negative_cache"`
+	PrimaryNameServer    types.String `tfsdk:"primary_name_server"`
+	Primaries            types.List   `tfsdk:"primaries"`
+	RecordCount          types.Int64  `tfsdk:"record_count"`
+	RefreshTime          types.Int64  `tfsdk:"refresh_time"`
+	RetryTime            types.Int64  `tfsdk:"retry_time"`
+	SerialNumber         types.Int64  `tfsdk:"serial_number"`
+	Type                 types.String `tfsdk:"type"`
+	Visibility           types.String `tfsdk:"visibility"`
+	State                types.String `tfsdk:"state"`
+	WaitForNameservers   types.Bool   `tfsdk:"wait_for_nameservers"`
+	CreateDefaultRecords types.Object `tfsdk:"create_default_records"`
 }
 
 // NewZoneResource is a helper function to simplify the provider implementation.
@@ -67,7 +76,8 @@ func NewZoneResource() resource.Resource {
 
 // zoneResource is the resource implementation.
 type zoneResource struct {
-	client *dns.APIClient
+	client          *dns.APIClient
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -93,12 +103,12 @@ func (r *zoneResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	if providerData.DnsCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "dns_custom_endpoint", providerData.DnsCustomEndpoint)
 		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
 			config.WithEndpoint(providerData.DnsCustomEndpoint),
 		)
 	} else {
 		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
 		)
 	}
 
@@ -109,6 +119,7 @@ func (r *zoneResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 	tflog.Info(ctx, "DNS zone client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
 }
 
 // Schema defines the schema for the resource.
@@ -173,11 +184,13 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"acl": schema.StringAttribute{
-				Description: "The access control list. E.g. `0.0.0.0/0,::/0`",
-				Optional:    true,
-				Computed:    true,
+				Description: "The access control list, a comma-separated list of networks in CIDR notation that are allowed to transfer the zone (AXFR), " +
+					"e.g. to let secondary name servers of another DNS provider pull the zone. E.g. `0.0.0.0/0,::/0`",
+				Optional: true,
+				Computed: true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(2000),
+					validate.CIDRList(),
 				},
 			},
 			"active": schema.BoolAttribute{
@@ -186,11 +199,12 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 			},
 			"contact_email": schema.StringAttribute{
-				Description: "A contact e-mail for the zone.",
+				Description: "A contact e-mail for the zone, published as the responsible mailbox (RNAME) of the SOA record. E.g. `hostmaster@example.com`",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(255),
+					validate.Email(),
 				},
 			},
 			"default_ttl": schema.Int64Attribute{
@@ -202,7 +216,7 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"expire_time": schema.Int64Attribute{
-				Description: "Expire time. E.g. 1209600.",
+				Description: "Expire time of the SOA record, i.e. the time in seconds after which secondary name servers stop answering for the zone if they can't reach the primary. Must be greater than the sum of `refresh_time` and `retry_time`. E.g. 1209600",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.Int64{
@@ -210,13 +224,13 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"is_reverse_zone": schema.BoolAttribute{
-				Description: "Specifies, if the zone is a reverse zone or not.",
-				Optional:    true,
-				Computed:    true,
-				Default:     booldefault.StaticBool(false),
+				Description: "Specifies, if the zone is a reverse zone or not. " +
+					"If not set, it defaults to `true` for zones whose `dns_name` ends with `in-addr.arpa` or `ip6.arpa` and to `false` otherwise.",
+				Optional: true,
+				Computed: true,
 			},
 			"negative_cache": schema.Int64Attribute{
-				Description: "Negative caching. E.g. 60",
+				Description: "Negative caching, i.e. the time in seconds for which resolvers cache the non-existence of a record (SOA minimum). E.g. 60",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.Int64{
@@ -224,16 +238,17 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"primaries": schema.ListAttribute{
-				Description: `Primary name server for secondary zone. E.g. ["1.2.3.4"]`,
+				Description: `Primary name servers (IP addresses) from which a secondary zone is transferred. Required if type is ` + "`secondary`" + `. E.g. ["1.2.3.4"]`,
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(10),
+					listvalidator.ValueStringsAre(validate.IP()),
 				},
 			},
 			"refresh_time": schema.Int64Attribute{
-				Description: "Refresh time. E.g. 3600",
+				Description: "Refresh time of the SOA record, i.e. the time in seconds after which secondary name servers check the primary for changes of the zone. E.g. 3600",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.Int64{
@@ -241,7 +256,7 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"retry_time": schema.Int64Attribute{
-				Description: "Retry time. E.g. 600",
+				Description: "Retry time of the SOA record, i.e. the time in seconds after which secondary name servers retry a failed refresh. Must be less than `refresh_time`. E.g. 600",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.Int64{
@@ -249,14 +264,52 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"type": schema.StringAttribute{
-				Description: "Zone type. E.g. `primary`",
+				Description: "Zone type. Use `secondary` to mirror a zone hosted elsewhere from the `primaries` name servers. Defaults to `primary`.",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("primary"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf("primary", "secondary"),
 				},
 			},
+			"wait_for_nameservers": schema.BoolAttribute{
+				Description: "If true, the creation of the zone only finishes once public DNS resolvers return the zone's `primary_name_server` in the NS records of `dns_name`, " +
+					"i.e. once the delegation from the parent zone is live. Useful if dependent resources, e.g. ACME certificates, need the zone to be resolvable. " +
+					"The delegation has to be set up at the parent zone, e.g. the registrar, while waiting. Waits at most 30 minutes, or the provider's default create timeout if set. " +
+					"If the delegation isn't live in time, the creation fails and the zone is marked as tainted, so it is replaced on the next apply. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"create_default_records": schema.SingleNestedAttribute{
+				Description: "Common bootstrap record sets that are managed together with the zone. " +
+					"They are created right after the zone, kept in sync on updates and deleted together with the zone. " +
+					"Apex A and www CNAME record sets that aren't configured here are left untouched, e.g. if they are managed with `stackit_dns_record_set`. " +
+					"If creating them fails, the zone is marked as tainted and replaced on the next apply. " +
+					"Only supported for zones of type `primary`. Manage further record sets with `stackit_dns_record_set`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"apex_a": schema.ListAttribute{
+						Description: `IPv4 addresses of the A record set of the zone apex, i.e. of ` + "`dns_name`" + `. E.g. ["1.2.3.4"]`,
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(validate.IPv4()),
+						},
+					},
+					"www_cname": schema.BoolAttribute{
+						Description: "If true, a CNAME record set `www` pointing to the zone apex is created. Defaults to `false`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
 			"primary_name_server": schema.StringAttribute{
 				Description: "Primary name server. FQDN.",
 				Computed:    true,
@@ -266,7 +319,7 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"serial_number": schema.Int64Attribute{
-				Description: "Serial number. E.g. `2022111400`.",
+				Description: "Serial number of the zone's SOA record. It is incremented by the API on every change of the zone and can be compared against the serial served by the name servers to track propagation. E.g. `2022111400`.",
 				Computed:    true,
 				Validators: []validator.Int64{
 					int64validator.AtLeast(0),
@@ -289,6 +342,146 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *zoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = checkPrimaries(model.Type, model.Primaries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = checkReverseZone(model.IsReverseZone, model.DnsName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = checkSOATimers(model.RefreshTime, model.RetryTime, model.ExpireTime)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = checkDefaultRecords(model.Type, model.CreateDefaultRecords)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan derives is_reverse_zone from the dns_name of new zones, if it isn't configured.
+// For existing zones the value from the state is kept, as it can't be changed.
+func (r *zoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configIsReverseZone types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_reverse_zone"), &configIsReverseZone)...)
+	if resp.Diagnostics.HasError() || !configIsReverseZone.IsNull() {
+		return
+	}
+
+	isReverseZone := types.BoolUnknown()
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("is_reverse_zone"), &isReverseZone)...)
+	} else {
+		var dnsName types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("dns_name"), &dnsName)...)
+		if !dnsName.IsUnknown() && !dnsName.IsNull() {
+			isReverseZone = types.BoolValue(isReverseDnsName(dnsName.ValueString()))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_reverse_zone"), isReverseZone)...)
+}
+
+// isReverseDnsName checks if dnsName is below one of the domains used for reverse DNS lookups.
+func isReverseDnsName(dnsName string) bool {
+	name := strings.ToLower(strings.TrimSuffix(dnsName, "."))
+	for _, suffix := range []string{"in-addr.arpa", "ip6.arpa"} {
+		if name == suffix || strings.HasSuffix(name, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkReverseZone validates that only zones for reverse DNS lookups are marked as reverse zones
+func checkReverseZone(isReverseZone types.Bool, dnsName types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isReverseZone.IsUnknown() || dnsName.IsUnknown() || dnsName.IsNull() || !isReverseZone.ValueBool() {
+		return diags
+	}
+	if !isReverseDnsName(dnsName.ValueString()) {
+		diags.AddAttributeError(path.Root("is_reverse_zone"), "Invalid reverse zone",
+			fmt.Sprintf("Reverse zones must be below `in-addr.arpa` or `ip6.arpa`, got dns_name %q", dnsName.ValueString()))
+	}
+	return diags
+}
+
+// checkSOATimers validates that the configured SOA timers are consistent, as recommended by RFC 1912:
+// a failed refresh must be retried before the next regular refresh and the zone must not expire before a refresh has been retried.
+// Timers that aren't configured are set by the API and not checked.
+func checkSOATimers(refreshTime, retryTime, expireTime types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	isSet := func(v types.Int64) bool {
+		return !v.IsNull() && !v.IsUnknown()
+	}
+	if isSet(refreshTime) && isSet(retryTime) && retryTime.ValueInt64() >= refreshTime.ValueInt64() {
+		diags.AddAttributeError(path.Root("retry_time"), "Invalid retry time",
+			fmt.Sprintf("retry_time (%d) must be less than refresh_time (%d)", retryTime.ValueInt64(), refreshTime.ValueInt64()))
+	}
+	if isSet(refreshTime) && isSet(retryTime) && isSet(expireTime) && expireTime.ValueInt64() <= refreshTime.ValueInt64()+retryTime.ValueInt64() {
+		diags.AddAttributeError(path.Root("expire_time"), "Invalid expire time",
+			fmt.Sprintf("expire_time (%d) must be greater than the sum of refresh_time (%d) and retry_time (%d)", expireTime.ValueInt64(), refreshTime.ValueInt64(), retryTime.ValueInt64()))
+	}
+	return diags
+}
+
+// checkDefaultRecords validates that default records are only configured for primary zones, as secondary zones are transferred from their primaries
+func checkDefaultRecords(zoneType types.String, defaultRecords types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if defaultRecords.IsNull() || defaultRecords.IsUnknown() {
+		return diags
+	}
+	if zoneType.ValueString() == "secondary" {
+		diags.AddAttributeError(path.Root("create_default_records"), "Unsupported default records", "Default records can't be created in zones of type `secondary`, their record sets are transferred from the primaries")
+	}
+	return diags
+}
+
+// checkPrimaries validates that primaries are only set for, and always set for, secondary zones
+func checkPrimaries(zoneType types.String, primaries types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if zoneType.IsUnknown() || primaries.IsUnknown() {
+		return diags
+	}
+	// The type defaults to primary
+	isSecondary := zoneType.ValueString() == "secondary"
+	hasPrimaries := !primaries.IsNull() && len(primaries.Elements()) > 0
+	if isSecondary && !hasPrimaries {
+		diags.AddAttributeError(path.Root("primaries"), "Missing primaries", "At least one primary name server is required for zones of type `secondary`")
+	}
+	if !isSecondary && hasPrimaries {
+		diags.AddAttributeError(path.Root("primaries"), "Invalid primaries", "Primary name servers can only be set for zones of type `secondary`")
+	}
+	return diags
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
@@ -311,6 +504,7 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Create new zone
 	createResp, err := r.client.CreateZone(ctx, projectId).CreateZonePayload(*payload).Execute()
 	if err != nil {
+		err = core.ServiceEnablementError(err, "DNS", projectId, serviceEnablementHint)
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
@@ -321,9 +515,15 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	zoneId := *createResp.Zone.Id
 
 	ctx = tflog.SetField(ctx, "zone_id", zoneId)
-	wr, err := dns.CreateZoneWaitHandler(ctx, r.client, projectId, zoneId).SetTimeout(10 * time.Minute).WaitWithContext(ctx)
+	wr, err := dns.CreateZoneWaitHandler(ctx, r.client, projectId, zoneId).SetTimeout(r.defaultTimeouts.CreateOr(10 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
+		// Track the zone, so it isn't left behind if the creation was interrupted or timed out
+		resp.Diagnostics.Append(core.SavePartialState(ctx, &resp.State, map[string]string{
+			"id":         core.JoinID(projectId, zoneId),
+			"project_id": projectId,
+			"zone_id":    zoneId,
+		})...)
 		return
 	}
 	got, ok := wr.(*dns.ZoneResponse)
@@ -341,6 +541,30 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !model.CreateDefaultRecords.IsNull() {
+		desired, err := toDefaultRecordSets(ctx, model.CreateDefaultRecords, model.DnsName.ValueString())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Creating default records: %v", err))
+			return
+		}
+		err = syncDefaultRecords(ctx, r.client, r.defaultTimeouts, projectId, zoneId, desired, nil)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Creating default records: %v", err))
+			return
+		}
+	}
+
+	if model.WaitForNameservers.ValueBool() {
+		err = waitForNameservers(ctx, net.DefaultResolver.LookupNS, model.DnsName.ValueString(), model.PrimaryNameServer.ValueString(), r.defaultTimeouts.CreateOr(nameserversWaitTimeout))
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Waiting for name servers: %v", err))
+			return
+		}
+	}
 	tflog.Info(ctx, "DNS zone created")
 }
 
@@ -369,6 +593,18 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	if !state.CreateDefaultRecords.IsNull() {
+		apexA, wwwCname, err := readDefaultRecords(ctx, r.client, projectId, zoneId, state.DnsName.ValueString())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zones", fmt.Sprintf("Reading default records: %v", err))
+			return
+		}
+		err = mapDefaultRecords(ctx, apexA, wwwCname, state.DnsName.ValueString(), &state)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
+			return
+		}
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -401,9 +637,9 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", err.Error())
 		return
 	}
-	wr, err := dns.UpdateZoneWaitHandler(ctx, r.client, projectId, zoneId).SetTimeout(10 * time.Minute).WaitWithContext(ctx)
+	wr, err := dns.UpdateZoneWaitHandler(ctx, r.client, projectId, zoneId).SetTimeout(r.defaultTimeouts.UpdateOr(10 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Instance update waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*dns.ZoneResponse)
@@ -412,6 +648,29 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Sync default records
+	var stateDefaultRecords types.Object
+	diags = req.State.GetAttribute(ctx, path.Root("create_default_records"), &stateDefaultRecords)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	desired, err := toDefaultRecordSets(ctx, model.CreateDefaultRecords, model.DnsName.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Updating default records: %v", err))
+		return
+	}
+	previous, err := toDefaultRecordSets(ctx, stateDefaultRecords, model.DnsName.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Updating default records: %v", err))
+		return
+	}
+	err = syncDefaultRecords(ctx, r.client, r.defaultTimeouts, projectId, zoneId, desired, previous)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Updating default records: %v", err))
+		return
+	}
+
 	// Fetch updated zone
 	zoneResp, err := r.client.GetZone(ctx, projectId, zoneId).Execute()
 	if err != nil {
@@ -449,9 +708,9 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone", err.Error())
 		return
 	}
-	_, err = dns.DeleteZoneWaitHandler(ctx, r.client, projectId, zoneId).SetTimeout(10 * time.Minute).WaitWithContext(ctx)
+	_, err = dns.DeleteZoneWaitHandler(ctx, r.client, projectId, zoneId).SetTimeout(r.defaultTimeouts.DeleteOr(10 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 
@@ -461,7 +720,7 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,zone_id
 func (r *zoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -478,6 +737,7 @@ func (r *zoneResource) ImportState(ctx context.Context, req resource.ImportState
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_nameservers"), false)...)
 	tflog.Info(ctx, "DNS zone state imported")
 }
 
@@ -512,7 +772,7 @@ func mapFields(zoneResp *dns.ZoneResponse, model *Model) error {
 		zoneId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 
 	if z.Primaries == nil {
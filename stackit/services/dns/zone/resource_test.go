@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
 )
 
 func TestMapFields(t *testing.T) {
@@ -349,3 +350,270 @@ func TestToPayloadUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckPrimaries(t *testing.T) {
+	tests := []struct {
+		description string
+		zoneType    types.String
+		primaries   types.List
+		isValid     bool
+	}{
+		{
+			description: "default_type_no_primaries",
+			zoneType:    types.StringNull(),
+			primaries:   types.ListNull(types.StringType),
+			isValid:     true,
+		},
+		{
+			description: "primary_no_primaries",
+			zoneType:    types.StringValue("primary"),
+			primaries:   types.ListValueMust(types.StringType, []attr.Value{}),
+			isValid:     true,
+		},
+		{
+			description: "primary_with_primaries",
+			zoneType:    types.StringValue("primary"),
+			primaries: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("1.2.3.4"),
+			}),
+			isValid: false,
+		},
+		{
+			description: "default_type_with_primaries",
+			zoneType:    types.StringNull(),
+			primaries: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("1.2.3.4"),
+			}),
+			isValid: false,
+		},
+		{
+			description: "secondary_with_primaries",
+			zoneType:    types.StringValue("secondary"),
+			primaries: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("1.2.3.4"),
+				types.StringValue("2001:db8::1"),
+			}),
+			isValid: true,
+		},
+		{
+			description: "secondary_no_primaries",
+			zoneType:    types.StringValue("secondary"),
+			primaries:   types.ListNull(types.StringType),
+			isValid:     false,
+		},
+		{
+			description: "secondary_empty_primaries",
+			zoneType:    types.StringValue("secondary"),
+			primaries:   types.ListValueMust(types.StringType, []attr.Value{}),
+			isValid:     false,
+		},
+		{
+			description: "unknown_primaries",
+			zoneType:    types.StringValue("secondary"),
+			primaries:   types.ListUnknown(types.StringType),
+			isValid:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkPrimaries(tt.zoneType, tt.primaries)
+
+			if tt.isValid && diags.HasError() {
+				t.Errorf("checkPrimaries failed on valid input: %v", core.DiagsToError(diags))
+			}
+			if !tt.isValid && !diags.HasError() {
+				t.Errorf("checkPrimaries didn't fail on invalid input")
+			}
+		})
+	}
+}
+
+func TestCheckSOATimers(t *testing.T) {
+	tests := []struct {
+		description string
+		refreshTime types.Int64
+		retryTime   types.Int64
+		expireTime  types.Int64
+		isValid     bool
+	}{
+		{
+			description: "not_set",
+			refreshTime: types.Int64Null(),
+			retryTime:   types.Int64Null(),
+			expireTime:  types.Int64Null(),
+			isValid:     true,
+		},
+		{
+			description: "ok",
+			refreshTime: types.Int64Value(3600),
+			retryTime:   types.Int64Value(600),
+			expireTime:  types.Int64Value(1209600),
+			isValid:     true,
+		},
+		{
+			description: "retry_not_less_than_refresh",
+			refreshTime: types.Int64Value(600),
+			retryTime:   types.Int64Value(600),
+			expireTime:  types.Int64Null(),
+			isValid:     false,
+		},
+		{
+			description: "expire_too_small",
+			refreshTime: types.Int64Value(3600),
+			retryTime:   types.Int64Value(600),
+			expireTime:  types.Int64Value(4200),
+			isValid:     false,
+		},
+		{
+			description: "expire_without_retry",
+			refreshTime: types.Int64Value(3600),
+			retryTime:   types.Int64Null(),
+			expireTime:  types.Int64Value(600),
+			isValid:     true,
+		},
+		{
+			description: "unknown_refresh",
+			refreshTime: types.Int64Unknown(),
+			retryTime:   types.Int64Value(7200),
+			expireTime:  types.Int64Value(60),
+			isValid:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkSOATimers(tt.refreshTime, tt.retryTime, tt.expireTime)
+
+			if tt.isValid && diags.HasError() {
+				t.Errorf("checkSOATimers failed on valid input: %v", core.DiagsToError(diags))
+			}
+			if !tt.isValid && !diags.HasError() {
+				t.Errorf("checkSOATimers didn't fail on invalid input")
+			}
+		})
+	}
+}
+
+func TestIsReverseDnsName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"example.com", false},
+		{"arpa", false},
+		{"notin-addr.arpa", false},
+		{"in-addr.arpa", true},
+		{"2.0.192.in-addr.arpa", true},
+		{"2.0.192.IN-ADDR.ARPA.", true},
+		{"8.b.d.0.1.0.0.2.ip6.arpa", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			output := isReverseDnsName(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestCheckReverseZone(t *testing.T) {
+	tests := []struct {
+		description   string
+		isReverseZone types.Bool
+		dnsName       types.String
+		isValid       bool
+	}{
+		{
+			description:   "reverse_zone",
+			isReverseZone: types.BoolValue(true),
+			dnsName:       types.StringValue("2.0.192.in-addr.arpa"),
+			isValid:       true,
+		},
+		{
+			description:   "not_set",
+			isReverseZone: types.BoolNull(),
+			dnsName:       types.StringValue("example.com"),
+			isValid:       true,
+		},
+		{
+			description:   "forward_zone",
+			isReverseZone: types.BoolValue(false),
+			dnsName:       types.StringValue("example.com"),
+			isValid:       true,
+		},
+		{
+			description:   "unknown_dns_name",
+			isReverseZone: types.BoolValue(true),
+			dnsName:       types.StringUnknown(),
+			isValid:       true,
+		},
+		{
+			description:   "forward_zone_marked_as_reverse",
+			isReverseZone: types.BoolValue(true),
+			dnsName:       types.StringValue("example.com"),
+			isValid:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkReverseZone(tt.isReverseZone, tt.dnsName)
+
+			if tt.isValid && diags.HasError() {
+				t.Errorf("checkReverseZone failed on valid input: %v", core.DiagsToError(diags))
+			}
+			if !tt.isValid && !diags.HasError() {
+				t.Errorf("checkReverseZone didn't fail on invalid input")
+			}
+		})
+	}
+}
+
+func TestCheckDefaultRecords(t *testing.T) {
+	defaultRecords := types.ObjectValueMust(defaultRecordsTypes, map[string]attr.Value{
+		"apex_a":    types.ListNull(types.StringType),
+		"www_cname": types.BoolValue(true),
+	})
+	tests := []struct {
+		description    string
+		zoneType       types.String
+		defaultRecords types.Object
+		isValid        bool
+	}{
+		{
+			description:    "primary",
+			zoneType:       types.StringValue("primary"),
+			defaultRecords: defaultRecords,
+			isValid:        true,
+		},
+		{
+			description:    "type_not_set",
+			zoneType:       types.StringNull(),
+			defaultRecords: defaultRecords,
+			isValid:        true,
+		},
+		{
+			description:    "secondary_without_default_records",
+			zoneType:       types.StringValue("secondary"),
+			defaultRecords: types.ObjectNull(defaultRecordsTypes),
+			isValid:        true,
+		},
+		{
+			description:    "secondary",
+			zoneType:       types.StringValue("secondary"),
+			defaultRecords: defaultRecords,
+			isValid:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkDefaultRecords(tt.zoneType, tt.defaultRecords)
+
+			if tt.isValid && diags.HasError() {
+				t.Errorf("checkDefaultRecords failed on valid input: %v", core.DiagsToError(diags))
+			}
+			if !tt.isValid && !diags.HasError() {
+				t.Errorf("checkDefaultRecords didn't fail on invalid input")
+			}
+		})
+	}
+}
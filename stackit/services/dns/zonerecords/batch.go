@@ -0,0 +1,137 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+)
+
+// maxParallelOperations is the maximum number of record sets that are changed at the same time.
+const maxParallelOperations = 10
+
+// waitTimeout is the timeout of the wait handler of a single record set operation,
+// unless the provider configures a default timeout for the operation.
+const waitTimeout = 1 * time.Minute
+
+// applyChanges applies the changes to the zone and adds the IDs of the record sets that are managed afterwards to result.
+// The DNS API has no batch endpoint, so the record sets are changed in parallel instead of one after the other.
+// All deletions are done before any record set is created, as a deleted record set may conflict with a new one, e.g. a CNAME.
+// If an operation fails, the remaining ones of the same phase are still carried out and all errors are returned.
+// Record sets that failed to be updated or deleted still exist, so they are kept in result to be retried on the next apply.
+func applyChanges(ctx context.Context, client *dns.APIClient, timeouts core.Timeouts, projectId, zoneId string, changes *recordSetChanges, result map[string]string) error {
+	var mu sync.Mutex
+	setResult := func(key, id string) {
+		mu.Lock()
+		defer mu.Unlock()
+		result[key] = id
+	}
+	deleteErr := runParallel(len(changes.delete), func(i int) error {
+		d := &changes.delete[i]
+		err := deleteRecordSet(ctx, client, timeouts, projectId, zoneId, d.id)
+		if err != nil {
+			setResult(d.key, d.id)
+		}
+		return err
+	})
+	if deleteErr != nil {
+		// The record sets to update are left as they are
+		for _, u := range changes.update {
+			result[u.recordSet.key()] = u.id
+		}
+		return deleteErr
+	}
+
+	updateErr := runParallel(len(changes.update), func(i int) error {
+		u := &changes.update[i]
+		err := updateRecordSet(ctx, client, timeouts, projectId, zoneId, u.id, &u.recordSet)
+		setResult(u.recordSet.key(), u.id)
+		return err
+	})
+	createErr := runParallel(len(changes.create), func(i int) error {
+		s := &changes.create[i]
+		recordSetId, err := createRecordSet(ctx, client, timeouts, projectId, zoneId, s)
+		if recordSetId != "" {
+			// Keep track of the record set even if waiting fails, so it isn't created twice
+			setResult(s.key(), recordSetId)
+		}
+		return err
+	})
+	return errors.Join(updateErr, createErr)
+}
+
+// runParallel calls fn for the indexes 0 to n-1, with at most maxParallelOperations calls running at the same time.
+func runParallel(n int, fn func(i int) error) error {
+	sem := make(chan struct{}, maxParallelOperations)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func createRecordSet(ctx context.Context, client *dns.APIClient, timeouts core.Timeouts, projectId, zoneId string, recordSet *zoneFileRecordSet) (string, error) {
+	var recordSetResp *dns.RecordSetResponse
+	err := core.RetryOnConflict(ctx, func() error {
+		var err error
+		recordSetResp, err = client.CreateRecordSet(ctx, projectId, zoneId).CreateRecordSetPayload(toCreatePayload(recordSet)).Execute()
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating record set %s: %w", recordSet.key(), err)
+	}
+	if recordSetResp.Rrset == nil || recordSetResp.Rrset.Id == nil {
+		return "", fmt.Errorf("creating record set %s: response has no record set id", recordSet.key())
+	}
+	recordSetId := *recordSetResp.Rrset.Id
+	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
+	_, err = dns.CreateRecordSetWaitHandler(ctx, client, projectId, zoneId, recordSetId).SetTimeout(timeouts.CreateOr(waitTimeout)).WaitWithContext(ctx)
+	if err != nil {
+		return recordSetId, fmt.Errorf("creating record set %s: waiting: %w", recordSet.key(), core.WaitError(ctx, err))
+	}
+	return recordSetId, nil
+}
+
+func updateRecordSet(ctx context.Context, client *dns.APIClient, timeouts core.Timeouts, projectId, zoneId, recordSetId string, recordSet *zoneFileRecordSet) error {
+	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
+	err := core.RetryOnConflict(ctx, func() error {
+		_, err := client.UpdateRecordSet(ctx, projectId, zoneId, recordSetId).UpdateRecordSetPayload(toUpdatePayload(recordSet)).Execute()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("updating record set %s: %w", recordSet.key(), err)
+	}
+	_, err = dns.UpdateRecordSetWaitHandler(ctx, client, projectId, zoneId, recordSetId).SetTimeout(timeouts.UpdateOr(waitTimeout)).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("updating record set %s: waiting: %w", recordSet.key(), core.WaitError(ctx, err))
+	}
+	return nil
+}
+
+func deleteRecordSet(ctx context.Context, client *dns.APIClient, timeouts core.Timeouts, projectId, zoneId, recordSetId string) error {
+	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
+	_, err := client.DeleteRecordSet(ctx, projectId, zoneId, recordSetId).Execute()
+	if err != nil {
+		return fmt.Errorf("deleting record set %s: %w", recordSetId, err)
+	}
+	_, err = dns.DeleteRecordSetWaitHandler(ctx, client, projectId, zoneId, recordSetId).SetTimeout(timeouts.DeleteOr(waitTimeout)).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting record set %s: waiting: %w", recordSetId, core.WaitError(ctx, err))
+	}
+	return nil
+}
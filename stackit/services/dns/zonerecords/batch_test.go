@@ -0,0 +1,72 @@
+package dns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+)
+
+func TestApplyChangesFailure(t *testing.T) {
+	// Every API call fails, so no record set is changed
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+	client, err := dns.NewAPIClient(config.WithEndpoint(server.URL), config.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("Creating the client: %v", err)
+	}
+
+	mail := zoneFileRecordSet{Name: "mail.example.com.", Type: "MX", Records: []string{"10 mx1.example.com."}}
+	created := zoneFileRecordSet{Name: "new.example.com.", Type: "A", Records: []string{"1.2.3.4"}}
+	tests := []struct {
+		description string
+		changes     recordSetChanges
+		expected    map[string]string
+	}{
+		{
+			"delete_fails",
+			recordSetChanges{
+				create: []zoneFileRecordSet{created},
+				update: []recordSetUpdate{{id: "rid-mail", recordSet: mail}},
+				delete: []recordSetDelete{{key: "old.example.com. A", id: "rid-old"}},
+			},
+			map[string]string{
+				"www.example.com. A":   "rid-www",
+				"old.example.com. A":   "rid-old",
+				"mail.example.com. MX": "rid-mail",
+			},
+		},
+		{
+			"update_and_create_fail",
+			recordSetChanges{
+				create: []zoneFileRecordSet{created},
+				update: []recordSetUpdate{{id: "rid-mail", recordSet: mail}},
+				delete: []recordSetDelete{},
+			},
+			map[string]string{
+				"www.example.com. A":   "rid-www",
+				"mail.example.com. MX": "rid-mail",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			result := map[string]string{"www.example.com. A": "rid-www"}
+			err := applyChanges(context.Background(), client, core.Timeouts{}, "pid", "zid", &tt.changes, result)
+			if err == nil {
+				t.Fatalf("Should have failed")
+			}
+			diff := cmp.Diff(result, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,501 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &recordSetBulkResource{}
+	_ resource.ResourceWithConfigure      = &recordSetBulkResource{}
+	_ resource.ResourceWithValidateConfig = &recordSetBulkResource{}
+)
+
+type BulkModel struct {
+	Id           types.String    `tfsdk:"id"` // needed by TF
+	ProjectId    types.String    `tfsdk:"project_id"`
+	ZoneId       types.String    `tfsdk:"zone_id"`
+	RecordSets   []BulkRecordSet `tfsdk:"record_sets"`
+	RecordSetIds types.Map       `tfsdk:"record_set_ids"`
+}
+
+type BulkRecordSet struct {
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	TTL     types.Int64  `tfsdk:"ttl"`
+	Records types.Set    `tfsdk:"records"`
+}
+
+// NewRecordSetBulkResource is a helper function to simplify the provider implementation.
+func NewRecordSetBulkResource() resource.Resource {
+	return &recordSetBulkResource{}
+}
+
+// recordSetBulkResource is the resource implementation.
+type recordSetBulkResource struct {
+	client          *dns.APIClient
+	defaultTimeouts core.Timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *recordSetBulkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record_set_bulk"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *recordSetBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	var apiClient *dns.APIClient
+	var err error
+	if providerData.DnsCustomEndpoint != "" {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+			config.WithEndpoint(providerData.DnsCustomEndpoint),
+		)
+	} else {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "DNS record set bulk client configured")
+	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
+}
+
+// Schema defines the schema for the resource.
+func (r *recordSetBulkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS record set bulk resource schema. Manages many record sets of a zone in a single resource, " +
+			"which is considerably faster than one `stackit_dns_record_set` resource per record set for large zones, as the record sets are changed in parallel. " +
+			"Record sets are created, or updated if a record set with the same name and type already exists. " +
+			"Record sets that were created or updated by this resource are deleted when they are removed from `record_sets` or when the resource is destroyed. " +
+			"Existing record sets that already match the configuration are left untouched and are not deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`zone_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the dns zone is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "The zone ID whose record sets are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"record_sets": schema.SetNestedAttribute{
+				Description: "The record sets to manage. Each combination of `name` and `type` may only appear once.",
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the record set. Names without a trailing dot are relative to the zone, e.g. `www`. `@` stands for the zone apex. " +
+								"A wildcard is only allowed as the leftmost label, e.g. `*.www`.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.Any(
+									stringvalidator.OneOf("@"),
+									validate.RecordSetName(),
+								),
+							},
+						},
+						"type": schema.StringAttribute{
+							Description: "The record set type. E.g. `A` or `CNAME`. A `CNAME` record set is neither allowed at the zone apex nor next to record sets of other types with the same name.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "Time to live. E.g. 3600. If not set, the TTL of an existing record set is kept and new record sets get the default TTL of the zone.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(30),
+								int64validator.AtMost(99999999),
+							},
+						},
+						"records": schema.SetAttribute{
+							Description: "Records.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"record_set_ids": schema.MapAttribute{
+				Description: "The IDs of the record sets managed by this resource, i.e. created or updated by it, keyed by the fully qualified record set name and type, e.g. `www.example.com. A`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *recordSetBulkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var recordSetsSet types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("record_sets"), &recordSetsSet)...)
+	if resp.Diagnostics.HasError() || recordSetsSet.IsNull() || recordSetsSet.IsUnknown() {
+		return
+	}
+	recordSets := []BulkRecordSet{}
+	for _, element := range recordSetsSet.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() || object.IsNull() {
+			continue
+		}
+		var s BulkRecordSet
+		resp.Diagnostics.Append(object.As(ctx, &s, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		recordSets = append(recordSets, s)
+		resp.Diagnostics.Append(validate.DNSRecords(ctx, s.Type, s.Records, path.Root("record_sets").AtSetValue(element).AtName("records"))...)
+	}
+	resp.Diagnostics.Append(checkDuplicateRecordSets(recordSets)...)
+
+	known := []zoneFileRecordSet{}
+	for _, s := range recordSets {
+		if s.Name.IsUnknown() || s.Type.IsUnknown() {
+			continue
+		}
+		known = append(known, zoneFileRecordSet{Name: s.Name.ValueString(), Type: s.Type.ValueString()})
+	}
+	err := checkCnameConflicts(known, "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("record_sets"), "Invalid record sets", err.Error())
+	}
+}
+
+// checkDuplicateRecordSets checks that no combination of name and type is configured twice.
+// The zone's DNS name is not known here, so relative and fully qualified names of the same record set are not detected.
+func checkDuplicateRecordSets(recordSets []BulkRecordSet) diag.Diagnostics {
+	var diags diag.Diagnostics
+	seen := map[string]bool{}
+	for _, s := range recordSets {
+		if s.Name.IsUnknown() || s.Type.IsUnknown() {
+			continue
+		}
+		key := recordSetKey(s.Name.ValueString(), s.Type.ValueString())
+		if seen[key] {
+			diags.AddAttributeError(
+				path.Root("record_sets"),
+				"Duplicate record set",
+				fmt.Sprintf("The record set with name %q and type %q is configured more than once.", s.Name.ValueString(), s.Type.ValueString()),
+			)
+		}
+		seen[key] = true
+	}
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *recordSetBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model BulkModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	model.RecordSetIds = types.MapNull(types.StringType)
+	err := r.sync(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating record sets", err.Error())
+		// Keep track of the record sets that were already created
+		if !model.RecordSetIds.IsNull() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		}
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "DNS record sets created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *recordSetBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model BulkModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	zoneDnsName, err := getZoneDnsName(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading record sets", err.Error())
+		return
+	}
+	remote, err := listRecordSets(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading record sets", err.Error())
+		return
+	}
+
+	err = mapBulkFields(ctx, remote, zoneDnsName, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "DNS record sets read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *recordSetBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model BulkModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel BulkModel
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	model.RecordSetIds = stateModel.RecordSetIds
+	err := r.sync(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating record sets", err.Error())
+		// Keep track of the record sets that were already changed
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "DNS record sets updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *recordSetBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model BulkModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	managed, err := recordSetIds(model.RecordSetIds)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record sets", err.Error())
+		return
+	}
+	remote, err := listRecordSets(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record sets", err.Error())
+		return
+	}
+	changes := computeChanges([]zoneFileRecordSet{}, indexRecordSets(remote), managed)
+	err = runParallel(len(changes.delete), func(i int) error {
+		return deleteRecordSet(ctx, r.client, r.defaultTimeouts, projectId, zoneId, changes.delete[i].id)
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record sets", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DNS record sets deleted")
+}
+
+// sync applies the configured record sets to the zone and sets the IDs of the managed record sets in the model.
+func (r *recordSetBulkResource) sync(ctx context.Context, model *BulkModel) error {
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	model.Id = types.StringValue(core.JoinID(projectId, zoneId))
+
+	zoneDnsName, err := getZoneDnsName(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		return err
+	}
+	desired, err := toZoneFileRecordSets(ctx, model.RecordSets, zoneDnsName)
+	if err != nil {
+		return err
+	}
+	err = checkCnameConflicts(desired, zoneDnsName)
+	if err != nil {
+		return err
+	}
+	managed, err := recordSetIds(model.RecordSetIds)
+	if err != nil {
+		return err
+	}
+	remote, err := listRecordSets(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		return err
+	}
+	changes := computeChanges(desired, indexRecordSets(remote), managed)
+
+	result := unchangedManagedRecordSets(&changes, managed)
+	defer func() {
+		model.RecordSetIds = toRecordSetsMap(result)
+	}()
+
+	return applyChanges(ctx, r.client, r.defaultTimeouts, projectId, zoneId, &changes, result)
+}
+
+// toZoneFileRecordSets converts the configured record sets, qualifying relative names with the zone's DNS name.
+func toZoneFileRecordSets(ctx context.Context, recordSets []BulkRecordSet, zoneDnsName string) ([]zoneFileRecordSet, error) {
+	result := make([]zoneFileRecordSet, 0, len(recordSets))
+	seen := map[string]bool{}
+	for i := range recordSets {
+		s := &recordSets[i]
+		records := []string{}
+		diags := s.Records.ElementsAs(ctx, &records, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping records of record set %q: %v", s.Name.ValueString(), diags.Errors())
+		}
+		recordSet := zoneFileRecordSet{
+			Name:    qualifyName(s.Name.ValueString(), zoneDnsName),
+			Type:    strings.ToUpper(s.Type.ValueString()),
+			TTL:     s.TTL.ValueInt64Pointer(),
+			Records: records,
+		}
+		if seen[recordSet.key()] {
+			return nil, fmt.Errorf("record set %s is configured more than once", recordSet.key())
+		}
+		seen[recordSet.key()] = true
+		result = append(result, recordSet)
+	}
+	return result, nil
+}
+
+// mapBulkFields refreshes the configured record sets from the existing ones.
+// Record sets deleted outside of Terraform are dropped, so they are created again on the next apply.
+// Existing record sets that aren't managed by this resource are kept as configured if they match, otherwise they are dropped, so they are updated on the next apply.
+func mapBulkFields(ctx context.Context, remote []dns.RecordSet, zoneDnsName string, model *BulkModel) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	managed, err := recordSetIds(model.RecordSetIds)
+	if err != nil {
+		return err
+	}
+	index := indexRecordSets(remote)
+
+	ids := map[string]string{}
+	recordSets := []BulkRecordSet{}
+	for _, s := range model.RecordSets {
+		key := recordSetKey(qualifyName(s.Name.ValueString(), zoneDnsName), s.Type.ValueString())
+		existing, ok := index[key]
+		if !ok || existing.Id == nil {
+			continue
+		}
+		configured := []string{}
+		diags := s.Records.ElementsAs(ctx, &configured, false)
+		if diags.HasError() {
+			return fmt.Errorf("mapping records of record set %s: %v", key, diags.Errors())
+		}
+		if *existing.Id != managed[key] {
+			// Existing record sets that already match aren't managed, other ones are updated on the next apply
+			desired := zoneFileRecordSet{Type: strings.ToUpper(s.Type.ValueString()), TTL: s.TTL.ValueInt64Pointer(), Records: configured}
+			if recordSetMatches(&desired, &existing) {
+				recordSets = append(recordSets, s)
+			}
+			continue
+		}
+		ids[key] = *existing.Id
+
+		if !s.TTL.IsNull() {
+			s.TTL = types.Int64PointerValue(toTTL(existing.Ttl))
+		}
+		records := existingRecords(&existing)
+		if !equalRecords(s.Type.ValueString(), configured, records) {
+			elements := make([]attr.Value, 0, len(records))
+			for _, record := range records {
+				elements = append(elements, types.StringValue(record))
+			}
+			recordsTF, diags := types.SetValue(types.StringType, elements)
+			if diags.HasError() {
+				return fmt.Errorf("mapping records of record set %s: %v", key, diags.Errors())
+			}
+			s.Records = recordsTF
+		}
+		recordSets = append(recordSets, s)
+	}
+	model.Id = types.StringValue(core.JoinID(model.ProjectId.ValueString(), model.ZoneId.ValueString()))
+	model.RecordSets = recordSets
+	model.RecordSetIds = toRecordSetsMap(ids)
+	return nil
+}
@@ -0,0 +1,383 @@
+package dns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func fixtureRecords(records ...string) types.Set {
+	elements := []attr.Value{}
+	for _, r := range records {
+		elements = append(elements, types.StringValue(r))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestToZoneFileRecordSets(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []BulkRecordSet
+		expected    []zoneFileRecordSet
+		isValid     bool
+	}{
+		{
+			"qualify_names",
+			[]BulkRecordSet{
+				{Name: types.StringValue("www"), Type: types.StringValue("a"), TTL: types.Int64Value(60), Records: fixtureRecords("1.2.3.4")},
+				{Name: types.StringValue("@"), Type: types.StringValue("MX"), TTL: types.Int64Null(), Records: fixtureRecords("10 mx.example.com.")},
+				{Name: types.StringValue("mail.example.com."), Type: types.StringValue("A"), TTL: types.Int64Null(), Records: fixtureRecords("5.6.7.8")},
+			},
+			[]zoneFileRecordSet{
+				{Name: "www.example.com.", Type: "A", TTL: utils.Ptr(int64(60)), Records: []string{"1.2.3.4"}},
+				{Name: "example.com.", Type: "MX", Records: []string{"10 mx.example.com."}},
+				{Name: "mail.example.com.", Type: "A", Records: []string{"5.6.7.8"}},
+			},
+			true,
+		},
+		{
+			"duplicate_fail",
+			[]BulkRecordSet{
+				{Name: types.StringValue("www"), Type: types.StringValue("A"), Records: fixtureRecords("1.2.3.4")},
+				{Name: types.StringValue("www.example.com."), Type: types.StringValue("a"), Records: fixtureRecords("5.6.7.8")},
+			},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toZoneFileRecordSets(context.Background(), tt.input, "example.com")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckDuplicateRecordSets(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []BulkRecordSet
+		isValid     bool
+	}{
+		{
+			"ok",
+			[]BulkRecordSet{
+				{Name: types.StringValue("www"), Type: types.StringValue("A")},
+				{Name: types.StringValue("www"), Type: types.StringValue("AAAA")},
+				{Name: types.StringUnknown(), Type: types.StringValue("A")},
+				{Name: types.StringUnknown(), Type: types.StringValue("A")},
+			},
+			true,
+		},
+		{
+			"duplicate",
+			[]BulkRecordSet{
+				{Name: types.StringValue("www"), Type: types.StringValue("A")},
+				{Name: types.StringValue("WWW"), Type: types.StringValue("a")},
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkDuplicateRecordSets(tt.input)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestMapBulkFields(t *testing.T) {
+	tests := []struct {
+		description string
+		remote      []dns.RecordSet
+		expected    BulkModel
+	}{
+		{
+			"in_sync",
+			[]dns.RecordSet{
+				fixtureRecordSet("rid-www", "www.example.com.", "A", 60, "1.2.3.4"),
+				fixtureRecordSet("rid-mail", "mail.example.com.", "MX", 3600, "10 MX1.example.com."),
+				fixtureRecordSet("rid-foreign", "foreign.example.com.", "A", 60, "9.9.9.9"),
+			},
+			BulkModel{
+				Id:        types.StringValue("pid,zid"),
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				RecordSets: []BulkRecordSet{
+					{Name: types.StringValue("www"), Type: types.StringValue("A"), TTL: types.Int64Value(60), Records: fixtureRecords("1.2.3.4")},
+					{Name: types.StringValue("mail"), Type: types.StringValue("mx"), TTL: types.Int64Null(), Records: fixtureRecords("10 mx1.example.com.")},
+				},
+				RecordSetIds: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"www.example.com. A":   types.StringValue("rid-www"),
+					"mail.example.com. MX": types.StringValue("rid-mail"),
+				}),
+			},
+		},
+		{
+			"changed_and_deleted_outside",
+			[]dns.RecordSet{
+				fixtureRecordSet("rid-www", "www.example.com.", "A", 120, "5.6.7.8"),
+				fixtureRecordSet("rid-new", "mail.example.com.", "MX", 3600, "10 mx1.example.com."),
+			},
+			BulkModel{
+				Id:        types.StringValue("pid,zid"),
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				RecordSets: []BulkRecordSet{
+					{Name: types.StringValue("www"), Type: types.StringValue("A"), TTL: types.Int64Value(120), Records: fixtureRecords("5.6.7.8")},
+					{Name: types.StringValue("mail"), Type: types.StringValue("mx"), TTL: types.Int64Null(), Records: fixtureRecords("10 mx1.example.com.")},
+				},
+				RecordSetIds: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"www.example.com. A": types.StringValue("rid-www"),
+				}),
+			},
+		},
+		{
+			"existing_record_set_not_matching",
+			[]dns.RecordSet{
+				fixtureRecordSet("rid-www", "www.example.com.", "A", 60, "1.2.3.4"),
+				fixtureRecordSet("rid-new", "mail.example.com.", "MX", 3600, "20 mx2.example.com."),
+			},
+			BulkModel{
+				Id:        types.StringValue("pid,zid"),
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				RecordSets: []BulkRecordSet{
+					{Name: types.StringValue("www"), Type: types.StringValue("A"), TTL: types.Int64Value(60), Records: fixtureRecords("1.2.3.4")},
+				},
+				RecordSetIds: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"www.example.com. A": types.StringValue("rid-www"),
+				}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &BulkModel{
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				RecordSets: []BulkRecordSet{
+					{Name: types.StringValue("www"), Type: types.StringValue("A"), TTL: types.Int64Value(60), Records: fixtureRecords("1.2.3.4")},
+					{Name: types.StringValue("mail"), Type: types.StringValue("mx"), TTL: types.Int64Null(), Records: fixtureRecords("10 mx1.example.com.")},
+				},
+				RecordSetIds: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"www.example.com. A":   types.StringValue("rid-www"),
+					"mail.example.com. MX": types.StringValue("rid-mail"),
+				}),
+			}
+			err := mapBulkFields(context.Background(), tt.remote, "example.com", state)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(state, &tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapBulkFieldsTXT(t *testing.T) {
+	tests := []struct {
+		description string
+		remote      string
+		expected    types.Set
+	}{
+		{
+			"rechunked",
+			"\"token=\" \"AbC\"",
+			fixtureRecords("\"token=AbC\""),
+		},
+		{
+			"case_changed",
+			"\"token=abc\"",
+			fixtureRecords("\"token=abc\""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &BulkModel{
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				RecordSets: []BulkRecordSet{
+					{Name: types.StringValue("@"), Type: types.StringValue("TXT"), TTL: types.Int64Null(), Records: fixtureRecords("\"token=AbC\"")},
+				},
+				RecordSetIds: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"example.com. TXT": types.StringValue("rid"),
+				}),
+			}
+			remote := []dns.RecordSet{fixtureRecordSet("rid", "example.com.", "TXT", 3600, tt.remote)}
+			err := mapBulkFields(context.Background(), remote, "example.com", state)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if len(state.RecordSets) != 1 {
+				t.Fatalf("Expected 1 record set, got %d", len(state.RecordSets))
+			}
+			diff := cmp.Diff(state.RecordSets[0].Records, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordSetNameValidation(t *testing.T) {
+	r := &recordSetBulkResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	recordSets, ok := schemaResp.Schema.Attributes["record_sets"].(schema.SetNestedAttribute)
+	if !ok {
+		t.Fatalf("record_sets is not a set of objects")
+	}
+	nameAttribute, ok := recordSets.NestedObject.Attributes["name"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("name is not a string")
+	}
+
+	tests := []struct {
+		description string
+		name        string
+		isValid     bool
+	}{
+		{"apex", "@", true},
+		{"relative", "www", true},
+		{"fully_qualified", "www.example.com.", true},
+		{"wildcard", "*.www", true},
+		{"empty_label", "www..example.com", false},
+		{"wildcard_not_leftmost", "www.*", false},
+		{"invalid_characters", "www!", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("name"),
+				ConfigValue: types.StringValue(tt.name),
+			}
+			resp := &validator.StringResponse{}
+			for _, v := range nameAttribute.Validators {
+				v.ValidateString(context.Background(), req, resp)
+			}
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestBulkValidateConfig(t *testing.T) {
+	recordSet := func(name, recordType string, records ...string) tftypes.Value {
+		recordValues := []tftypes.Value{}
+		for _, r := range records {
+			recordValues = append(recordValues, tftypes.NewValue(tftypes.String, r))
+		}
+		return tftypes.NewValue(recordSetObjectType, map[string]tftypes.Value{
+			"name":    tftypes.NewValue(tftypes.String, name),
+			"type":    tftypes.NewValue(tftypes.String, recordType),
+			"ttl":     tftypes.NewValue(tftypes.Number, nil),
+			"records": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, recordValues),
+		})
+	}
+	tests := []struct {
+		description string
+		recordSets  []tftypes.Value
+		isValid     bool
+	}{
+		{
+			"ok",
+			[]tftypes.Value{
+				recordSet("www", "A", "1.2.3.4"),
+				recordSet("@", "TXT", "\"v=spf1 -all\""),
+			},
+			true,
+		},
+		{
+			"invalid_record",
+			[]tftypes.Value{
+				recordSet("www", "A", "not-an-ip"),
+			},
+			false,
+		},
+		{
+			"duplicate",
+			[]tftypes.Value{
+				recordSet("www", "A", "1.2.3.4"),
+				recordSet("WWW", "a", "5.6.7.8"),
+			},
+			false,
+		},
+		{
+			"cname_conflict",
+			[]tftypes.Value{
+				recordSet("www", "A", "1.2.3.4"),
+				recordSet("www", "CNAME", "example.org."),
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := context.Background()
+			r := &recordSetBulkResource{}
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["record_sets"] = tftypes.NewValue(tftypes.Set{ElementType: recordSetObjectType}, tt.recordSets)
+
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Raw: tftypes.NewValue(objectType, values), Schema: schemaResp.Schema},
+			}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, req, resp)
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+var recordSetObjectType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"name":    tftypes.String,
+		"type":    tftypes.String,
+		"ttl":     tftypes.Number,
+		"records": tftypes.Set{ElementType: tftypes.String},
+	},
+}
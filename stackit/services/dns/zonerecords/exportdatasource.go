@@ -0,0 +1,185 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &zoneExportDataSource{}
+)
+
+type ExportModel struct {
+	Id              types.String `tfsdk:"id"` // needed by TF
+	ProjectId       types.String `tfsdk:"project_id"`
+	ZoneId          types.String `tfsdk:"zone_id"`
+	IncludeInactive types.Bool   `tfsdk:"include_inactive"`
+	ZoneFile        types.String `tfsdk:"zone_file"`
+}
+
+// NewZoneExportDataSource is a helper function to simplify the provider implementation.
+func NewZoneExportDataSource() datasource.DataSource {
+	return &zoneExportDataSource{}
+}
+
+// zoneExportDataSource is the data source implementation.
+type zoneExportDataSource struct {
+	client *dns.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *zoneExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_export"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *zoneExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	var apiClient *dns.APIClient
+	var err error
+	if providerData.DnsCustomEndpoint != "" {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+			config.WithEndpoint(providerData.DnsCustomEndpoint),
+		)
+	} else {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DNS zone export client configured")
+	d.client = apiClient
+}
+
+// Schema defines the schema for the data source.
+func (d *zoneExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS zone export data source schema. Renders the record sets of a zone as a zone file, e.g. for backups or to transfer the zone to another DNS provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`,`zone_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the dns zone is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "The zone ID whose record sets are exported.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"include_inactive": schema.BoolAttribute{
+				Description: "If true, record sets that are not active are exported as well. Defaults to `false`.",
+				Optional:    true,
+			},
+			"zone_file": schema.StringAttribute{
+				Description: "The record sets of the zone in the format described in RFC 1035 Section 5, with fully qualified names and sorted by name and type. " +
+					"The SOA record and the NS records of the zone apex are included as returned by the API. " +
+					"The content can be used as `zone_file` of the `stackit_dns_zone_records` resource, which ignores these records.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *zoneExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state ExportModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	state.ZoneId = core.CanonicalizeIDValue(state.ZoneId)
+	projectId := state.ProjectId.ValueString()
+	zoneId := state.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	zoneDnsName, err := getZoneDnsName(ctx, d.client, projectId, zoneId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone export", err.Error())
+		return
+	}
+	remote, err := listRecordSets(ctx, d.client, projectId, zoneId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone export", err.Error())
+		return
+	}
+
+	err = mapExportFields(remote, zoneDnsName, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "DNS zone export read")
+}
+
+func mapExportFields(remote []dns.RecordSet, zoneDnsName string, model *ExportModel) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	includeInactive := model.IncludeInactive.ValueBool()
+
+	recordSets := []zoneFileRecordSet{}
+	for i := range remote {
+		s := &remote[i]
+		if s.Name == nil || s.Type == nil {
+			return fmt.Errorf("record set %d: name or type not present", i)
+		}
+		if !includeInactive && s.Active != nil && !*s.Active {
+			continue
+		}
+		recordSets = append(recordSets, zoneFileRecordSet{
+			Name:    *s.Name,
+			Type:    *s.Type,
+			TTL:     toTTL(s.Ttl),
+			Records: existingRecords(s),
+		})
+	}
+	model.Id = types.StringValue(core.JoinID(model.ProjectId.ValueString(), model.ZoneId.ValueString()))
+	model.ZoneFile = types.StringValue(renderZoneFile(zoneDnsName, recordSets))
+	return nil
+}
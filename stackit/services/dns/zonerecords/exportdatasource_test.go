@@ -0,0 +1,69 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func TestMapExportFields(t *testing.T) {
+	inactive := fixtureRecordSet("rid-old", "old.example.com.", "A", 60, "9.9.9.9")
+	inactive.Active = utils.Ptr(false)
+	remote := []dns.RecordSet{
+		fixtureRecordSet("rid-www", "www.example.com.", "A", 60, "5.6.7.8", "1.2.3.4"),
+		fixtureRecordSet("rid-mail", "example.com.", "MX", 3600, "10 mx1.example.com."),
+		inactive,
+	}
+	tests := []struct {
+		description     string
+		includeInactive types.Bool
+		expected        string
+	}{
+		{
+			"default",
+			types.BoolNull(),
+			`$ORIGIN example.com.
+example.com. 3600 IN MX 10 mx1.example.com.
+www.example.com. 60 IN A 1.2.3.4
+www.example.com. 60 IN A 5.6.7.8
+`,
+		},
+		{
+			"include_inactive",
+			types.BoolValue(true),
+			`$ORIGIN example.com.
+example.com. 3600 IN MX 10 mx1.example.com.
+old.example.com. 60 IN A 9.9.9.9
+www.example.com. 60 IN A 1.2.3.4
+www.example.com. 60 IN A 5.6.7.8
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &ExportModel{
+				ProjectId:       types.StringValue("pid"),
+				ZoneId:          types.StringValue("zid"),
+				IncludeInactive: tt.includeInactive,
+			}
+			err := mapExportFields(remote, "example.com", state)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			expected := &ExportModel{
+				Id:              types.StringValue("pid,zid"),
+				ProjectId:       types.StringValue("pid"),
+				ZoneId:          types.StringValue("zid"),
+				IncludeInactive: tt.includeInactive,
+				ZoneFile:        types.StringValue(tt.expected),
+			}
+			diff := cmp.Diff(state, expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
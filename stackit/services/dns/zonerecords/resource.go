@@ -0,0 +1,617 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// pageSize is the number of record sets requested per page when listing.
+const pageSize = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &zoneRecordsResource{}
+	_ resource.ResourceWithConfigure      = &zoneRecordsResource{}
+	_ resource.ResourceWithValidateConfig = &zoneRecordsResource{}
+)
+
+type Model struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	ProjectId  types.String `tfsdk:"project_id"`
+	ZoneId     types.String `tfsdk:"zone_id"`
+	ZoneFile   types.String `tfsdk:"zone_file"`
+	RecordSets types.Map    `tfsdk:"record_sets"`
+}
+
+// NewZoneRecordsResource is a helper function to simplify the provider implementation.
+func NewZoneRecordsResource() resource.Resource {
+	return &zoneRecordsResource{}
+}
+
+// zoneRecordsResource is the resource implementation.
+type zoneRecordsResource struct {
+	client          *dns.APIClient
+	defaultTimeouts core.Timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *zoneRecordsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_records"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *zoneRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	var apiClient *dns.APIClient
+	var err error
+	if providerData.DnsCustomEndpoint != "" {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+			config.WithEndpoint(providerData.DnsCustomEndpoint),
+		)
+	} else {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "DNS zone records client configured")
+	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
+}
+
+// Schema defines the schema for the resource.
+func (r *zoneRecordsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS zone records resource schema. Manages all record sets of a zone from the content of a BIND zone file. " +
+			"Record sets present in the zone file are created, or updated if a record set with the same name and type already exists. " +
+			"Record sets that were created or updated by this resource are deleted when they are removed from the zone file or when the resource is destroyed. " +
+			"Existing record sets that already match the zone file are left untouched and are not deleted. " +
+			"The SOA record and the NS records of the zone apex are managed by the DNS API and therefore ignored.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`zone_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the dns zone is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "The zone ID whose record sets are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"zone_file": schema.StringAttribute{
+				Description: "Content of a zone file in the format described in RFC 1035 Section 5, e.g. exported from another DNS provider. " +
+					"Relative names are qualified with the `$ORIGIN` of the file or, if not set, with the DNS name of the zone. " +
+					"The `$INCLUDE` and `$GENERATE` directives are not supported. " +
+					"If the record sets are changed outside of Terraform, this attribute shows the difference as a rendered zone file.",
+				Required: true,
+			},
+			"record_sets": schema.MapAttribute{
+				Description: "The IDs of the record sets managed by this resource, i.e. created or updated by it, keyed by the record set name and type, e.g. `www.example.com. A`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *zoneRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.ZoneFile.IsUnknown() || model.ZoneFile.IsNull() {
+		return
+	}
+	// The zone's DNS name is not known here, so relative names are left unqualified
+	records, err := parseZoneFile(model.ZoneFile.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("zone_file"), "Invalid zone file", err.Error())
+		return
+	}
+	recordSets := make([]zoneFileRecordSet, 0, len(records))
+	for _, r := range records {
+		recordSets = append(recordSets, zoneFileRecordSet{Name: r.Name, Type: r.Type})
+	}
+	err = checkCnameConflicts(recordSets, "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("zone_file"), "Invalid zone file", err.Error())
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *zoneRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	model.RecordSets = types.MapNull(types.StringType)
+	err := r.sync(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone records", err.Error())
+		// Keep track of the record sets that were already created
+		if !model.RecordSets.IsNull() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		}
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "DNS zone records created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *zoneRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	zoneDnsName, err := getZoneDnsName(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone records", err.Error())
+		return
+	}
+	remote, err := listRecordSets(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone records", err.Error())
+		return
+	}
+
+	err = mapFields(remote, zoneDnsName, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "DNS zone records read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *zoneRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	model.RecordSets = stateModel.RecordSets
+	err := r.sync(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone records", err.Error())
+		// Keep track of the record sets that were already changed
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "DNS zone records updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *zoneRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+
+	managed, err := recordSetIds(model.RecordSets)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone records", err.Error())
+		return
+	}
+	remote, err := listRecordSets(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone records", err.Error())
+		return
+	}
+	changes := computeChanges([]zoneFileRecordSet{}, indexRecordSets(remote), managed)
+	err = runParallel(len(changes.delete), func(i int) error {
+		return deleteRecordSet(ctx, r.client, r.defaultTimeouts, projectId, zoneId, changes.delete[i].id)
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone records", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DNS zone records deleted")
+}
+
+// sync applies the zone file of the model to the zone and sets the IDs of the managed record sets in the model.
+func (r *zoneRecordsResource) sync(ctx context.Context, model *Model) error {
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	model.Id = types.StringValue(core.JoinID(projectId, zoneId))
+
+	zoneDnsName, err := getZoneDnsName(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		return err
+	}
+	records, err := parseZoneFile(model.ZoneFile.ValueString(), zoneDnsName)
+	if err != nil {
+		return fmt.Errorf("parsing zone file: %w", err)
+	}
+	desired, err := groupRecordSets(records, zoneDnsName)
+	if err != nil {
+		return fmt.Errorf("parsing zone file: %w", err)
+	}
+	err = checkCnameConflicts(desired, zoneDnsName)
+	if err != nil {
+		return fmt.Errorf("invalid zone file: %w", err)
+	}
+	managed, err := recordSetIds(model.RecordSets)
+	if err != nil {
+		return err
+	}
+	remote, err := listRecordSets(ctx, r.client, projectId, zoneId)
+	if err != nil {
+		return err
+	}
+	changes := computeChanges(desired, indexRecordSets(remote), managed)
+
+	result := unchangedManagedRecordSets(&changes, managed)
+	defer func() {
+		model.RecordSets = toRecordSetsMap(result)
+	}()
+
+	return applyChanges(ctx, r.client, r.defaultTimeouts, projectId, zoneId, &changes, result)
+}
+
+func getZoneDnsName(ctx context.Context, client *dns.APIClient, projectId, zoneId string) (string, error) {
+	zoneResp, err := client.GetZone(ctx, projectId, zoneId).Execute()
+	if err != nil {
+		return "", fmt.Errorf("reading zone: %w", err)
+	}
+	if zoneResp.Zone == nil || zoneResp.Zone.DnsName == nil {
+		return "", fmt.Errorf("reading zone: DNS name not present")
+	}
+	return *zoneResp.Zone.DnsName, nil
+}
+
+// listRecordSets returns all record sets of the zone that are not deleted, handling pagination.
+func listRecordSets(ctx context.Context, client *dns.APIClient, projectId, zoneId string) ([]dns.RecordSet, error) {
+	recordSets := []dns.RecordSet{}
+	for page := int32(1); ; page++ {
+		listResp, err := client.GetRecordSets(ctx, projectId, zoneId).Page(page).PageSize(pageSize).StateNeq(dns.DeleteSuccess).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("listing record sets, page %d: %w", page, err)
+		}
+		if listResp.RrSets != nil {
+			recordSets = append(recordSets, *listResp.RrSets...)
+		}
+		if listResp.TotalPages == nil || page >= *listResp.TotalPages {
+			return recordSets, nil
+		}
+	}
+}
+
+// recordSetChanges are the API calls needed to bring the zone to the desired state.
+type recordSetChanges struct {
+	create []zoneFileRecordSet
+	update []recordSetUpdate
+	delete []recordSetDelete
+	// unchanged maps the keys of the desired record sets that already match to their record set ID
+	unchanged map[string]string
+}
+
+type recordSetUpdate struct {
+	id        string
+	recordSet zoneFileRecordSet
+}
+
+type recordSetDelete struct {
+	key string
+	id  string
+}
+
+// computeChanges compares the desired record sets with the existing ones.
+// Only record sets that are managed, i.e. were created or updated by this resource, are deleted.
+func computeChanges(desired []zoneFileRecordSet, remote map[string]dns.RecordSet, managed map[string]string) recordSetChanges {
+	changes := recordSetChanges{
+		create:    []zoneFileRecordSet{},
+		update:    []recordSetUpdate{},
+		delete:    []recordSetDelete{},
+		unchanged: map[string]string{},
+	}
+	desiredKeys := map[string]bool{}
+	for _, s := range desired {
+		desiredKeys[s.key()] = true
+		existing, ok := remote[s.key()]
+		switch {
+		case !ok || existing.Id == nil:
+			changes.create = append(changes.create, s)
+		case recordSetMatches(&s, &existing):
+			changes.unchanged[s.key()] = *existing.Id
+		default:
+			changes.update = append(changes.update, recordSetUpdate{id: *existing.Id, recordSet: s})
+		}
+	}
+
+	managedKeys := make([]string, 0, len(managed))
+	for key := range managed {
+		managedKeys = append(managedKeys, key)
+	}
+	sort.Strings(managedKeys)
+	for _, key := range managedKeys {
+		if desiredKeys[key] {
+			continue
+		}
+		existing, ok := remote[key]
+		if !ok || existing.Id == nil || *existing.Id != managed[key] {
+			// Already gone or replaced outside of Terraform
+			continue
+		}
+		changes.delete = append(changes.delete, recordSetDelete{key: key, id: managed[key]})
+	}
+	return changes
+}
+
+// unchangedManagedRecordSets returns the IDs of the unchanged record sets that are already managed by this resource.
+// Existing record sets that already match the desired ones are not managed, so they are kept when removed from the configuration
+// or when the resource is destroyed. Record sets that are created or updated are added to the result by applyChanges.
+func unchangedManagedRecordSets(changes *recordSetChanges, managed map[string]string) map[string]string {
+	result := map[string]string{}
+	for key, id := range changes.unchanged {
+		if managed[key] == id {
+			result[key] = id
+		}
+	}
+	return result
+}
+
+// recordSetMatches reports whether the existing record set has the desired TTL and records.
+// If the zone file doesn't specify a TTL, the TTL of the existing record set is accepted.
+func recordSetMatches(desired *zoneFileRecordSet, existing *dns.RecordSet) bool {
+	if desired.TTL != nil && (existing.Ttl == nil || int64(*existing.Ttl) != *desired.TTL) {
+		return false
+	}
+	return equalRecords(desired.Type, desired.Records, existingRecords(existing))
+}
+
+// equalRecords reports whether the records of a record set of the given type are equal, regardless of their order.
+// The records are compared in their canonical form, so e.g. a TXT record re-chunked by the API is still equal.
+func equalRecords(recordType string, a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := map[string]int{}
+	for _, r := range a {
+		count[core.CanonicalDNSRecord(recordType, r)]++
+	}
+	for _, r := range b {
+		count[core.CanonicalDNSRecord(recordType, r)]--
+	}
+	for _, c := range count {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func existingRecords(recordSet *dns.RecordSet) []string {
+	records := []string{}
+	if recordSet.Records == nil {
+		return records
+	}
+	for _, record := range *recordSet.Records {
+		if record.Content != nil {
+			records = append(records, *record.Content)
+		}
+	}
+	return records
+}
+
+func indexRecordSets(recordSets []dns.RecordSet) map[string]dns.RecordSet {
+	index := map[string]dns.RecordSet{}
+	for _, s := range recordSets {
+		if s.Name == nil || s.Type == nil {
+			continue
+		}
+		index[recordSetKey(*s.Name, *s.Type)] = s
+	}
+	return index
+}
+
+func recordSetIds(recordSets types.Map) (map[string]string, error) {
+	ids := map[string]string{}
+	for key, value := range recordSets.Elements() {
+		id, ok := value.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("expected record set id of %s to be of type %T, got %T", key, types.String{}, value)
+		}
+		ids[key] = id.ValueString()
+	}
+	return ids, nil
+}
+
+func toRecordSetsMap(ids map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for key, id := range ids {
+		elements[key] = types.StringValue(id)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// mapFields refreshes the managed record sets from the existing ones.
+// Record sets deleted outside of Terraform are dropped. If the existing record sets differ from the zone file,
+// the zone file is replaced by a rendering of the existing record sets, so the difference shows up in the plan.
+func mapFields(remote []dns.RecordSet, zoneDnsName string, model *Model) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	managed, err := recordSetIds(model.RecordSets)
+	if err != nil {
+		return err
+	}
+	index := indexRecordSets(remote)
+
+	records, err := parseZoneFile(model.ZoneFile.ValueString(), zoneDnsName)
+	if err != nil {
+		return fmt.Errorf("parsing zone file: %w", err)
+	}
+	desired, err := groupRecordSets(records, zoneDnsName)
+	if err != nil {
+		return fmt.Errorf("parsing zone file: %w", err)
+	}
+	desiredKeys := map[string]bool{}
+	for _, s := range desired {
+		desiredKeys[s.key()] = true
+	}
+
+	ids := map[string]string{}
+	current := []zoneFileRecordSet{}
+	for key, existing := range index {
+		if existing.Id == nil {
+			continue
+		}
+		isManaged := managed[key] == *existing.Id
+		if isManaged {
+			ids[key] = *existing.Id
+		}
+		// Existing record sets that match the zone file aren't managed, but are part of it
+		if !isManaged && !desiredKeys[key] {
+			continue
+		}
+		current = append(current, zoneFileRecordSet{
+			Name:    *existing.Name,
+			Type:    *existing.Type,
+			TTL:     toTTL(existing.Ttl),
+			Records: existingRecords(&existing),
+		})
+	}
+	model.Id = types.StringValue(core.JoinID(model.ProjectId.ValueString(), model.ZoneId.ValueString()))
+	model.RecordSets = toRecordSetsMap(ids)
+
+	changes := computeChanges(desired, index, managed)
+	if len(changes.create) > 0 || len(changes.update) > 0 {
+		model.ZoneFile = types.StringValue(renderZoneFile(zoneDnsName, current))
+	}
+	return nil
+}
+
+func toTTL(ttl *int32) *int64 {
+	if ttl == nil {
+		return nil
+	}
+	return utils.Ptr(int64(*ttl))
+}
+
+func toCreatePayload(recordSet *zoneFileRecordSet) dns.CreateRecordSetPayload {
+	return dns.CreateRecordSetPayload{
+		Name:    utils.Ptr(recordSet.Name),
+		Records: toRecordPayloads(recordSet.Records),
+		Ttl:     toPtrInt32(recordSet.TTL),
+		Type:    utils.Ptr(recordSet.Type),
+	}
+}
+
+func toUpdatePayload(recordSet *zoneFileRecordSet) dns.UpdateRecordSetPayload {
+	return dns.UpdateRecordSetPayload{
+		Name:    utils.Ptr(recordSet.Name),
+		Records: toRecordPayloads(recordSet.Records),
+		Ttl:     toPtrInt32(recordSet.TTL),
+	}
+}
+
+func toRecordPayloads(records []string) *[]dns.RecordPayload {
+	payloads := []dns.RecordPayload{}
+	for _, record := range records {
+		payloads = append(payloads, dns.RecordPayload{
+			Content: utils.Ptr(record),
+		})
+	}
+	return &payloads
+}
+
+func toPtrInt32(ttl *int64) *int32 {
+	if ttl == nil {
+		return nil
+	}
+	return utils.Ptr(int32(*ttl))
+}
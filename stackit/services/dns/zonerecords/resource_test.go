@@ -0,0 +1,289 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func fixtureRecordSet(id, name, recordType string, ttl int32, records ...string) dns.RecordSet {
+	contents := []dns.Record{}
+	for _, r := range records {
+		contents = append(contents, dns.Record{Content: utils.Ptr(r)})
+	}
+	return dns.RecordSet{
+		Id:      utils.Ptr(id),
+		Name:    utils.Ptr(name),
+		Type:    utils.Ptr(recordType),
+		Ttl:     utils.Ptr(ttl),
+		Records: &contents,
+	}
+}
+
+func TestComputeChanges(t *testing.T) {
+	tests := []struct {
+		description string
+		desired     []zoneFileRecordSet
+		remote      []dns.RecordSet
+		managed     map[string]string
+		expected    recordSetChanges
+	}{
+		{
+			"create_all",
+			[]zoneFileRecordSet{
+				{Name: "www.example.com.", Type: "A", Records: []string{"1.2.3.4"}},
+			},
+			[]dns.RecordSet{},
+			map[string]string{},
+			recordSetChanges{
+				create: []zoneFileRecordSet{
+					{Name: "www.example.com.", Type: "A", Records: []string{"1.2.3.4"}},
+				},
+				update:    []recordSetUpdate{},
+				delete:    []recordSetDelete{},
+				unchanged: map[string]string{},
+			},
+		},
+		{
+			"unchanged_update_adopt_and_delete",
+			[]zoneFileRecordSet{
+				{Name: "www.example.com.", TTL: utils.Ptr(int64(60)), Type: "A", Records: []string{"1.2.3.4", "5.6.7.8"}},
+				{Name: "mail.example.com.", Type: "MX", Records: []string{"10 mx1.example.com."}},
+				{Name: "other.example.com.", Type: "CNAME", Records: []string{"www.example.com."}},
+			},
+			[]dns.RecordSet{
+				fixtureRecordSet("rid-www", "www.example.com.", "A", 60, "5.6.7.8", "1.2.3.4"),
+				fixtureRecordSet("rid-mail", "mail.example.com.", "MX", 3600, "20 mx2.example.com."),
+				fixtureRecordSet("rid-other", "Other.example.com.", "CNAME", 3600, "old.example.com."),
+				fixtureRecordSet("rid-old", "old.example.com.", "A", 3600, "9.9.9.9"),
+				fixtureRecordSet("rid-foreign", "foreign.example.com.", "A", 3600, "9.9.9.9"),
+			},
+			map[string]string{
+				"www.example.com. A":   "rid-www",
+				"mail.example.com. MX": "rid-mail",
+				"old.example.com. A":   "rid-old",
+				"gone.example.com. A":  "rid-gone",
+			},
+			recordSetChanges{
+				create: []zoneFileRecordSet{},
+				update: []recordSetUpdate{
+					{id: "rid-mail", recordSet: zoneFileRecordSet{Name: "mail.example.com.", Type: "MX", Records: []string{"10 mx1.example.com."}}},
+					{id: "rid-other", recordSet: zoneFileRecordSet{Name: "other.example.com.", Type: "CNAME", Records: []string{"www.example.com."}}},
+				},
+				delete: []recordSetDelete{{key: "old.example.com. A", id: "rid-old"}},
+				unchanged: map[string]string{
+					"www.example.com. A": "rid-www",
+				},
+			},
+		},
+		{
+			"ttl_changed",
+			[]zoneFileRecordSet{
+				{Name: "www.example.com.", TTL: utils.Ptr(int64(120)), Type: "A", Records: []string{"1.2.3.4"}},
+			},
+			[]dns.RecordSet{
+				fixtureRecordSet("rid", "www.example.com.", "A", 60, "1.2.3.4"),
+			},
+			map[string]string{"www.example.com. A": "rid"},
+			recordSetChanges{
+				create: []zoneFileRecordSet{},
+				update: []recordSetUpdate{
+					{id: "rid", recordSet: zoneFileRecordSet{Name: "www.example.com.", TTL: utils.Ptr(int64(120)), Type: "A", Records: []string{"1.2.3.4"}}},
+				},
+				delete:    []recordSetDelete{},
+				unchanged: map[string]string{},
+			},
+		},
+		{
+			"txt_rechunked",
+			[]zoneFileRecordSet{
+				{Name: "example.com.", Type: "TXT", Records: []string{"\"v=spf1 include:example.org -all\""}},
+			},
+			[]dns.RecordSet{
+				fixtureRecordSet("rid", "example.com.", "TXT", 3600, "\"v=spf1 \" \"include:example.org -all\""),
+			},
+			map[string]string{"example.com. TXT": "rid"},
+			recordSetChanges{
+				create:    []zoneFileRecordSet{},
+				update:    []recordSetUpdate{},
+				delete:    []recordSetDelete{},
+				unchanged: map[string]string{"example.com. TXT": "rid"},
+			},
+		},
+		{
+			"txt_case_changed",
+			[]zoneFileRecordSet{
+				{Name: "example.com.", Type: "TXT", Records: []string{"\"token=AbC\""}},
+			},
+			[]dns.RecordSet{
+				fixtureRecordSet("rid", "example.com.", "TXT", 3600, "\"token=abc\""),
+			},
+			map[string]string{"example.com. TXT": "rid"},
+			recordSetChanges{
+				create: []zoneFileRecordSet{},
+				update: []recordSetUpdate{
+					{id: "rid", recordSet: zoneFileRecordSet{Name: "example.com.", Type: "TXT", Records: []string{"\"token=AbC\""}}},
+				},
+				delete:    []recordSetDelete{},
+				unchanged: map[string]string{},
+			},
+		},
+		{
+			"hostname_case_and_trailing_dot",
+			[]zoneFileRecordSet{
+				{Name: "www.example.com.", Type: "CNAME", Records: []string{"Target.Example.com."}},
+			},
+			[]dns.RecordSet{
+				fixtureRecordSet("rid", "www.example.com.", "CNAME", 3600, "target.example.com"),
+			},
+			map[string]string{"www.example.com. CNAME": "rid"},
+			recordSetChanges{
+				create:    []zoneFileRecordSet{},
+				update:    []recordSetUpdate{},
+				delete:    []recordSetDelete{},
+				unchanged: map[string]string{"www.example.com. CNAME": "rid"},
+			},
+		},
+		{
+			"do_not_delete_replaced_record_set",
+			[]zoneFileRecordSet{},
+			[]dns.RecordSet{
+				fixtureRecordSet("rid-new", "www.example.com.", "A", 60, "1.2.3.4"),
+			},
+			map[string]string{"www.example.com. A": "rid-old"},
+			recordSetChanges{
+				create:    []zoneFileRecordSet{},
+				update:    []recordSetUpdate{},
+				delete:    []recordSetDelete{},
+				unchanged: map[string]string{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := computeChanges(tt.desired, indexRecordSets(tt.remote), tt.managed)
+			diff := cmp.Diff(output, tt.expected, cmp.AllowUnexported(recordSetChanges{}, recordSetUpdate{}, recordSetDelete{}))
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnchangedManagedRecordSets(t *testing.T) {
+	changes := &recordSetChanges{
+		unchanged: map[string]string{
+			"www.example.com. A":      "rid-www",
+			"existing.example.com. A": "rid-existing",
+			"replaced.example.com. A": "rid-new",
+		},
+	}
+	managed := map[string]string{
+		"www.example.com. A":      "rid-www",
+		"replaced.example.com. A": "rid-old",
+	}
+	expected := map[string]string{
+		"www.example.com. A": "rid-www",
+	}
+	output := unchangedManagedRecordSets(changes, managed)
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestMapFields(t *testing.T) {
+	zoneFile := `$TTL 60
+www A 1.2.3.4
+mail MX 10 mx1
+`
+	tests := []struct {
+		description string
+		remote      []dns.RecordSet
+		managed     map[string]attr.Value
+		expected    Model
+	}{
+		{
+			"in_sync",
+			[]dns.RecordSet{
+				fixtureRecordSet("rid-www", "www.example.com.", "A", 60, "1.2.3.4"),
+				fixtureRecordSet("rid-mail", "mail.example.com.", "MX", 60, "10 mx1.example.com."),
+				fixtureRecordSet("rid-foreign", "foreign.example.com.", "A", 60, "9.9.9.9"),
+			},
+			map[string]attr.Value{
+				"www.example.com. A":   types.StringValue("rid-www"),
+				"mail.example.com. MX": types.StringValue("rid-mail"),
+			},
+			Model{
+				Id:        types.StringValue("pid,zid"),
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				ZoneFile:  types.StringValue(zoneFile),
+				RecordSets: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"www.example.com. A":   types.StringValue("rid-www"),
+					"mail.example.com. MX": types.StringValue("rid-mail"),
+				}),
+			},
+		},
+		{
+			"existing_record_set_not_managed",
+			[]dns.RecordSet{
+				fixtureRecordSet("rid-www", "www.example.com.", "A", 60, "1.2.3.4"),
+				fixtureRecordSet("rid-mail", "mail.example.com.", "MX", 60, "10 mx1.example.com."),
+			},
+			map[string]attr.Value{
+				"www.example.com. A": types.StringValue("rid-www"),
+			},
+			Model{
+				Id:        types.StringValue("pid,zid"),
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				ZoneFile:  types.StringValue(zoneFile),
+				RecordSets: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"www.example.com. A": types.StringValue("rid-www"),
+				}),
+			},
+		},
+		{
+			"changed_and_deleted_outside",
+			[]dns.RecordSet{
+				fixtureRecordSet("rid-www", "www.example.com.", "A", 60, "5.6.7.8"),
+			},
+			map[string]attr.Value{
+				"www.example.com. A":   types.StringValue("rid-www"),
+				"mail.example.com. MX": types.StringValue("rid-mail"),
+			},
+			Model{
+				Id:        types.StringValue("pid,zid"),
+				ProjectId: types.StringValue("pid"),
+				ZoneId:    types.StringValue("zid"),
+				ZoneFile:  types.StringValue("$ORIGIN example.com.\nwww.example.com. 60 IN A 5.6.7.8\n"),
+				RecordSets: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"www.example.com. A": types.StringValue("rid-www"),
+				}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId:  types.StringValue("pid"),
+				ZoneId:     types.StringValue("zid"),
+				ZoneFile:   types.StringValue(zoneFile),
+				RecordSets: types.MapValueMust(types.StringType, tt.managed),
+			}
+			err := mapFields(tt.remote, "example.com", state)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(state, &tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,432 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// zoneFileRecord is a single resource record read from a zone file.
+type zoneFileRecord struct {
+	Name string
+	TTL  *int64
+	Type string
+	Data string
+}
+
+// zoneFileRecordSet groups the records of a zone file with the same name and type.
+type zoneFileRecordSet struct {
+	Name    string
+	Type    string
+	TTL     *int64
+	Records []string
+}
+
+// key identifies the record set within a zone, e.g. "www.example.com. A".
+func (s *zoneFileRecordSet) key() string {
+	return recordSetKey(s.Name, s.Type)
+}
+
+func recordSetKey(name, recordType string) string {
+	return strings.ToLower(name) + " " + strings.ToUpper(recordType)
+}
+
+// parseZoneFile parses the content of a zone file in the format described in RFC 1035 Section 5.
+// Relative names are qualified with the $ORIGIN of the file or, if no $ORIGIN is set yet, with origin.
+// If origin is empty as well, relative names are returned unqualified.
+// The $INCLUDE and $GENERATE directives are not supported.
+func parseZoneFile(content, origin string) ([]zoneFileRecord, error) {
+	lines, err := zoneFileLines(content)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []zoneFileRecord{}
+	var defaultTTL *int64
+	var lastTTL *int64
+	lastOwner := ""
+	for _, l := range lines {
+		tokens := l.tokens
+		if strings.HasPrefix(tokens[0], "$") && !l.blankOwner {
+			switch strings.ToUpper(tokens[0]) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN expects exactly one argument", l.number)
+				}
+				origin = qualifyName(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL expects exactly one argument", l.number)
+				}
+				ttl, err := parseTTL(tokens[1])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", l.number, err)
+				}
+				defaultTTL = &ttl
+			default:
+				return nil, fmt.Errorf("line %d: directive %s is not supported", l.number, tokens[0])
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !l.blankOwner {
+			owner = qualifyName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record without owner name", l.number)
+		}
+		lastOwner = owner
+
+		// The TTL and the class are optional and may appear in any order
+		var ttl *int64
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if ttl == nil && len(tokens[0]) > 0 && unicode.IsDigit(rune(tokens[0][0])) {
+				t, err := parseTTL(tokens[0])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", l.number, err)
+				}
+				ttl = &t
+				tokens = tokens[1:]
+				continue
+			}
+			if isClass(tokens[0]) {
+				if strings.ToUpper(tokens[0]) != "IN" {
+					return nil, fmt.Errorf("line %d: class %s is not supported, only IN is", l.number, tokens[0])
+				}
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		switch {
+		case ttl != nil:
+			lastTTL = ttl
+		case defaultTTL != nil:
+			ttl = defaultTTL
+		default:
+			ttl = lastTTL
+		}
+
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: expected record type and data", l.number)
+		}
+		recordType := strings.ToUpper(tokens[0])
+		for _, c := range recordType {
+			if !unicode.IsUpper(c) && !unicode.IsDigit(c) {
+				return nil, fmt.Errorf("line %d: invalid record type %q", l.number, tokens[0])
+			}
+		}
+		data := qualifyRecordData(recordType, tokens[1:], origin)
+
+		records = append(records, zoneFileRecord{
+			Name: owner,
+			TTL:  ttl,
+			Type: recordType,
+			Data: strings.Join(data, " "),
+		})
+	}
+	return records, nil
+}
+
+// groupRecordSets groups the records by name and type, keeping the order of first appearance.
+// The SOA record and the NS records of the zone apex are skipped, as they are managed by the DNS API.
+func groupRecordSets(records []zoneFileRecord, zoneDnsName string) ([]zoneFileRecordSet, error) {
+	apex := strings.ToLower(qualifyName(zoneDnsName, ""))
+	if !strings.HasSuffix(apex, ".") {
+		apex += "."
+	}
+
+	recordSets := []zoneFileRecordSet{}
+	index := map[string]int{}
+	for _, r := range records {
+		if r.Type == "SOA" || (r.Type == "NS" && strings.ToLower(r.Name) == apex) {
+			continue
+		}
+		if !strings.HasSuffix(r.Name, ".") {
+			return nil, fmt.Errorf("record name %q is not fully qualified", r.Name)
+		}
+		key := recordSetKey(r.Name, r.Type)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(recordSets)
+			recordSets = append(recordSets, zoneFileRecordSet{
+				Name:    r.Name,
+				Type:    r.Type,
+				TTL:     r.TTL,
+				Records: []string{r.Data},
+			})
+			continue
+		}
+		s := &recordSets[i]
+		if (s.TTL == nil) != (r.TTL == nil) || (s.TTL != nil && *s.TTL != *r.TTL) {
+			return nil, fmt.Errorf("records of %s have different TTLs", key)
+		}
+		duplicate := false
+		for _, existing := range s.Records {
+			if existing == r.Data {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			s.Records = append(s.Records, r.Data)
+		}
+	}
+	return recordSets, nil
+}
+
+// checkCnameConflicts checks that there is no CNAME record set at the zone apex and none that shares its name
+// with a record set of another type, as both are forbidden by RFC 1034 Section 3.6.2 and rejected by the DNS API.
+// The zone apex is "@" or, if zoneDnsName is not empty, the zone's DNS name. Other names are compared as given.
+func checkCnameConflicts(recordSets []zoneFileRecordSet, zoneDnsName string) error {
+	apex := ""
+	if zoneDnsName != "" {
+		apex = strings.ToLower(qualifyName(zoneDnsName, ""))
+		if !strings.HasSuffix(apex, ".") {
+			apex += "."
+		}
+	}
+
+	typesByName := map[string][]string{}
+	names := []string{}
+	for _, s := range recordSets {
+		name := strings.ToLower(s.Name)
+		recordType := strings.ToUpper(s.Type)
+		if recordType == "CNAME" && (name == "@" || name == apex) {
+			return fmt.Errorf("record set %s: a CNAME record is not allowed at the zone apex", recordSetKey(s.Name, s.Type))
+		}
+		recordTypes, ok := typesByName[name]
+		if !ok {
+			names = append(names, name)
+		}
+		if !containsString(recordTypes, recordType) {
+			typesByName[name] = append(recordTypes, recordType)
+		}
+	}
+	for _, name := range names {
+		recordTypes := typesByName[name]
+		if len(recordTypes) < 2 || !containsString(recordTypes, "CNAME") {
+			continue
+		}
+		others := []string{}
+		for _, t := range recordTypes {
+			if t != "CNAME" {
+				others = append(others, t)
+			}
+		}
+		return fmt.Errorf("name %s has a CNAME record and records of type %s, a CNAME record can't coexist with other records", name, strings.Join(others, ", "))
+	}
+	return nil
+}
+
+// renderZoneFile renders the record sets as zone file content, so it can be compared with or replace the configured content.
+func renderZoneFile(zoneDnsName string, recordSets []zoneFileRecordSet) string {
+	origin := zoneDnsName
+	if !strings.HasSuffix(origin, ".") {
+		origin += "."
+	}
+	sorted := make([]zoneFileRecordSet, len(recordSets))
+	copy(sorted, recordSets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].key() < sorted[j].key()
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", origin)
+	for _, s := range sorted {
+		records := make([]string, len(s.Records))
+		copy(records, s.Records)
+		sort.Strings(records)
+		for _, r := range records {
+			if s.TTL != nil {
+				fmt.Fprintf(&b, "%s %d IN %s %s\n", s.Name, *s.TTL, s.Type, r)
+			} else {
+				fmt.Fprintf(&b, "%s IN %s %s\n", s.Name, s.Type, r)
+			}
+		}
+	}
+	return b.String()
+}
+
+// zoneFileLine is a logical line of a zone file, i.e. with comments removed and parentheses joined.
+type zoneFileLine struct {
+	number     int
+	blankOwner bool
+	tokens     []string
+}
+
+func zoneFileLines(content string) ([]zoneFileLine, error) {
+	lines := []zoneFileLine{}
+	var current *zoneFileLine
+	depth := 0
+	for i, raw := range strings.Split(content, "\n") {
+		raw = strings.TrimRight(raw, "\r")
+		tokens, parens, err := tokenizeZoneFileLine(raw)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if current == nil {
+			if len(tokens) == 0 && parens == 0 {
+				continue
+			}
+			current = &zoneFileLine{
+				number:     i + 1,
+				blankOwner: raw != "" && (raw[0] == ' ' || raw[0] == '\t'),
+			}
+		}
+		current.tokens = append(current.tokens, tokens...)
+		depth += parens
+		if depth < 0 {
+			return nil, fmt.Errorf("line %d: unbalanced parentheses", i+1)
+		}
+		if depth == 0 {
+			if len(current.tokens) > 0 {
+				lines = append(lines, *current)
+			}
+			current = nil
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses at end of zone file")
+	}
+	return lines, nil
+}
+
+// tokenizeZoneFileLine splits a physical line into tokens, dropping comments.
+// Quoted strings are kept as a single token including the quotes.
+// It also returns the balance of opening and closing parentheses found outside quotes.
+func tokenizeZoneFileLine(line string) (tokens []string, parens int, err error) {
+	var token strings.Builder
+	inQuotes := false
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line):
+			token.WriteByte(c)
+			token.WriteByte(line[i+1])
+			i++
+		case c == '"':
+			token.WriteByte(c)
+			inQuotes = !inQuotes
+			if !inQuotes {
+				flush()
+			}
+		case inQuotes:
+			token.WriteByte(c)
+		case c == ';':
+			flush()
+			return tokens, parens, nil
+		case c == '(':
+			flush()
+			parens++
+		case c == ')':
+			flush()
+			parens--
+		case c == ' ' || c == '\t':
+			flush()
+		default:
+			token.WriteByte(c)
+		}
+	}
+	if inQuotes {
+		return nil, 0, fmt.Errorf("unterminated quoted string")
+	}
+	flush()
+	return tokens, parens, nil
+}
+
+// parseTTL parses a TTL in seconds, optionally using the BIND units s, m, h, d and w, e.g. "1h30m".
+func parseTTL(s string) (int64, error) {
+	if v, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if v < 0 {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		return v, nil
+	}
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var total, current int64
+	digits := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			current = current*10 + int64(c-'0')
+			digits++
+			continue
+		}
+		unit, ok := units[byte(unicode.ToLower(rune(c)))]
+		if !ok || digits == 0 {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		total += current * unit
+		current, digits = 0, 0
+	}
+	if s == "" || digits != 0 || total > 1<<31-1 {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return total, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func isClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS", "CS", "ANY":
+		return true
+	}
+	return false
+}
+
+// qualifyName makes name absolute by appending origin. "@" stands for the origin itself.
+func qualifyName(name, origin string) string {
+	if origin != "" && !strings.HasSuffix(origin, ".") {
+		origin += "."
+	}
+	switch {
+	case name == "@":
+		if origin == "" {
+			return name
+		}
+		return origin
+	case strings.HasSuffix(name, "."), origin == "":
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// qualifyRecordData qualifies the domain names contained in the record data of the given type.
+func qualifyRecordData(recordType string, data []string, origin string) []string {
+	nameField := -1
+	switch recordType {
+	case "CNAME", "NS", "PTR", "DNAME", "ALIAS":
+		nameField = 0
+	case "MX":
+		nameField = 1
+	case "SRV":
+		nameField = 3
+	}
+	if nameField < 0 || nameField >= len(data) {
+		return data
+	}
+	qualified := make([]string, len(data))
+	copy(qualified, data)
+	qualified[nameField] = qualifyName(data[nameField], origin)
+	return qualified
+}
@@ -0,0 +1,346 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		origin      string
+		expected    []zoneFileRecord
+		isValid     bool
+	}{
+		{
+			"empty",
+			"",
+			"example.com",
+			[]zoneFileRecord{},
+			true,
+		},
+		{
+			"simple_records",
+			`
+$TTL 3600
+@       IN  A     1.2.3.4
+www     300 IN A  5.6.7.8
+        IN  AAAA  2001:db8::1 ; comment
+mail.example.com. IN 60 MX 10 mx1
+`,
+			"example.com",
+			[]zoneFileRecord{
+				{Name: "example.com.", TTL: utils.Ptr(int64(3600)), Type: "A", Data: "1.2.3.4"},
+				{Name: "www.example.com.", TTL: utils.Ptr(int64(300)), Type: "A", Data: "5.6.7.8"},
+				{Name: "www.example.com.", TTL: utils.Ptr(int64(3600)), Type: "AAAA", Data: "2001:db8::1"},
+				{Name: "mail.example.com.", TTL: utils.Ptr(int64(60)), Type: "MX", Data: "10 mx1.example.com."},
+			},
+			true,
+		},
+		{
+			"origin_directive",
+			`$ORIGIN sub.example.com.
+www CNAME @
+_sip._tcp SRV 10 60 5060 sip`,
+			"example.com",
+			[]zoneFileRecord{
+				{Name: "www.sub.example.com.", Type: "CNAME", Data: "sub.example.com."},
+				{Name: "_sip._tcp.sub.example.com.", Type: "SRV", Data: "10 60 5060 sip.sub.example.com."},
+			},
+			true,
+		},
+		{
+			"ttl_without_default_uses_previous",
+			`a 1h A 1.2.3.4
+b A 5.6.7.8`,
+			"example.com.",
+			[]zoneFileRecord{
+				{Name: "a.example.com.", TTL: utils.Ptr(int64(3600)), Type: "A", Data: "1.2.3.4"},
+				{Name: "b.example.com.", TTL: utils.Ptr(int64(3600)), Type: "A", Data: "5.6.7.8"},
+			},
+			true,
+		},
+		{
+			"parentheses_and_quotes",
+			`@ IN SOA ns1 hostmaster (
+    2023010101 ; serial
+    3600 )
+@ TXT "v=spf1 include:example.net ~all" "second ; not a comment"`,
+			"example.com",
+			[]zoneFileRecord{
+				{Name: "example.com.", Type: "SOA", Data: "ns1 hostmaster 2023010101 3600"},
+				{Name: "example.com.", Type: "TXT", Data: `"v=spf1 include:example.net ~all" "second ; not a comment"`},
+			},
+			true,
+		},
+		{
+			"no_origin",
+			`www A 1.2.3.4`,
+			"",
+			[]zoneFileRecord{
+				{Name: "www", Type: "A", Data: "1.2.3.4"},
+			},
+			true,
+		},
+		{
+			"unsupported_directive",
+			`$INCLUDE other.zone`,
+			"example.com",
+			nil,
+			false,
+		},
+		{
+			"unsupported_class",
+			`www CH A 1.2.3.4`,
+			"example.com",
+			nil,
+			false,
+		},
+		{
+			"missing_data",
+			`www A`,
+			"example.com",
+			nil,
+			false,
+		},
+		{
+			"blank_owner_first",
+			`  A 1.2.3.4`,
+			"example.com",
+			nil,
+			false,
+		},
+		{
+			"unbalanced_parentheses",
+			`@ SOA ns1 hostmaster ( 1 2`,
+			"example.com",
+			nil,
+			false,
+		},
+		{
+			"unterminated_quote",
+			`@ TXT "abc`,
+			"example.com",
+			nil,
+			false,
+		},
+		{
+			"invalid_ttl",
+			`$TTL 1x`,
+			"example.com",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := parseZoneFile(tt.input, tt.origin)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestGroupRecordSets(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []zoneFileRecord
+		expected    []zoneFileRecordSet
+		isValid     bool
+	}{
+		{
+			"grouping",
+			[]zoneFileRecord{
+				{Name: "example.com.", Type: "SOA", Data: "ns1 hostmaster 1 2 3 4 5"},
+				{Name: "example.com.", Type: "NS", Data: "ns1.example.com."},
+				{Name: "sub.example.com.", Type: "NS", Data: "ns1.other.com."},
+				{Name: "www.example.com.", TTL: utils.Ptr(int64(60)), Type: "A", Data: "1.2.3.4"},
+				{Name: "WWW.example.com.", TTL: utils.Ptr(int64(60)), Type: "A", Data: "5.6.7.8"},
+				{Name: "www.example.com.", TTL: utils.Ptr(int64(60)), Type: "A", Data: "1.2.3.4"},
+				{Name: "www.example.com.", Type: "AAAA", Data: "2001:db8::1"},
+			},
+			[]zoneFileRecordSet{
+				{Name: "sub.example.com.", Type: "NS", Records: []string{"ns1.other.com."}},
+				{Name: "www.example.com.", TTL: utils.Ptr(int64(60)), Type: "A", Records: []string{"1.2.3.4", "5.6.7.8"}},
+				{Name: "www.example.com.", Type: "AAAA", Records: []string{"2001:db8::1"}},
+			},
+			true,
+		},
+		{
+			"different_ttls",
+			[]zoneFileRecord{
+				{Name: "www.example.com.", TTL: utils.Ptr(int64(60)), Type: "A", Data: "1.2.3.4"},
+				{Name: "www.example.com.", TTL: utils.Ptr(int64(120)), Type: "A", Data: "5.6.7.8"},
+			},
+			nil,
+			false,
+		},
+		{
+			"not_fully_qualified",
+			[]zoneFileRecord{
+				{Name: "www", Type: "A", Data: "1.2.3.4"},
+			},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := groupRecordSets(tt.input, "example.com")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	recordSets := []zoneFileRecordSet{
+		{Name: "www.example.com.", TTL: utils.Ptr(int64(60)), Type: "A", Records: []string{"5.6.7.8", "1.2.3.4"}},
+		{Name: "mail.example.com.", Type: "MX", Records: []string{"10 mx1.example.com."}},
+	}
+	expected := `$ORIGIN example.com.
+mail.example.com. IN MX 10 mx1.example.com.
+www.example.com. 60 IN A 1.2.3.4
+www.example.com. 60 IN A 5.6.7.8
+`
+	output := renderZoneFile("example.com", recordSets)
+	if output != expected {
+		t.Fatalf("Rendered zone file does not match: %s", cmp.Diff(output, expected))
+	}
+
+	// The rendered zone file must parse to the same record sets
+	records, err := parseZoneFile(output, "example.com")
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	grouped, err := groupRecordSets(records, "example.com")
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if len(grouped) != len(recordSets) {
+		t.Fatalf("Expected %d record sets, got %d", len(recordSets), len(grouped))
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		isValid  bool
+	}{
+		{"0", 0, true},
+		{"3600", 3600, true},
+		{"1h", 3600, true},
+		{"1h30m", 5400, true},
+		{"1W2D", 777600, true},
+		{"", 0, false},
+		{"-1", 0, false},
+		{"1h30", 0, false},
+		{"h", 0, false},
+		{"1x", 0, false},
+		{"99999999999", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			output, err := parseTTL(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && output != tt.expected {
+				t.Fatalf("Expected %d, got %d", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestCheckCnameConflicts(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []zoneFileRecordSet
+		zoneDnsName string
+		isValid     bool
+	}{
+		{
+			"ok",
+			[]zoneFileRecordSet{
+				{Name: "example.com.", Type: "A"},
+				{Name: "example.com.", Type: "MX"},
+				{Name: "www.example.com.", Type: "CNAME"},
+				{Name: "mail.example.com.", Type: "A"},
+				{Name: "mail.example.com.", Type: "A"},
+			},
+			"example.com",
+			true,
+		},
+		{
+			"cname_at_apex",
+			[]zoneFileRecordSet{
+				{Name: "Example.com.", Type: "CNAME"},
+			},
+			"example.com",
+			false,
+		},
+		{
+			"cname_at_relative_apex",
+			[]zoneFileRecordSet{
+				{Name: "@", Type: "cname"},
+			},
+			"",
+			false,
+		},
+		{
+			"cname_with_other_type",
+			[]zoneFileRecordSet{
+				{Name: "www", Type: "CNAME"},
+				{Name: "WWW", Type: "TXT"},
+			},
+			"",
+			false,
+		},
+		{
+			"unknown_apex",
+			[]zoneFileRecordSet{
+				{Name: "example.com.", Type: "CNAME"},
+			},
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := checkCnameConflicts(tt.input, tt.zoneDnsName)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
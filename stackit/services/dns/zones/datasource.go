@@ -0,0 +1,277 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// pageSize is the number of zones requested per page when listing.
+const pageSize = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &zonesDataSource{}
+)
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ProjectId types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	Active    types.Bool   `tfsdk:"active"`
+	State     types.String `tfsdk:"state"`
+	Zones     []Zone       `tfsdk:"zones"`
+}
+
+type Zone struct {
+	ZoneId            types.String `tfsdk:"zone_id"`
+	Name              types.String `tfsdk:"name"`
+	DnsName           types.String `tfsdk:"dns_name"`
+	Description       types.String `tfsdk:"description"`
+	Acl               types.String `tfsdk:"acl"`
+	Active            types.Bool   `tfsdk:"active"`
+	Type              types.String `tfsdk:"type"`
+	IsReverseZone     types.Bool   `tfsdk:"is_reverse_zone"`
+	PrimaryNameServer types.String `tfsdk:"primary_name_server"`
+	RecordCount       types.Int64  `tfsdk:"record_count"`
+	Visibility        types.String `tfsdk:"visibility"`
+	State             types.String `tfsdk:"state"`
+}
+
+// NewZonesDataSource is a helper function to simplify the provider implementation.
+func NewZonesDataSource() datasource.DataSource {
+	return &zonesDataSource{}
+}
+
+// zonesDataSource is the data source implementation.
+type zonesDataSource struct {
+	client *dns.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *zonesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zones"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *zonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	var apiClient *dns.APIClient
+	var err error
+	if providerData.DnsCustomEndpoint != "" {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+			config.WithEndpoint(providerData.DnsCustomEndpoint),
+		)
+	} else {
+		apiClient, err = dns.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("dns")),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DNS zones client configured")
+	d.client = apiClient
+}
+
+// Schema defines the schema for the data source.
+func (d *zonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS zones data source schema. Lists the DNS zones of a project, optionally filtered by name, active flag and state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the zones are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "If set, only zones with exactly this user given name are returned.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "If set, only zones with this active flag are returned.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "If set, only zones in this state are returned. E.g. `CREATE_SUCCEEDED`. If not set, deleted zones are omitted.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"zones": schema.ListNestedAttribute{
+				Description: "The zones of the project matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"zone_id": schema.StringAttribute{
+							Description: "The zone ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The user given name of the zone.",
+							Computed:    true,
+						},
+						"dns_name": schema.StringAttribute{
+							Description: "The zone name. E.g. `example.com`",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the zone.",
+							Computed:    true,
+						},
+						"acl": schema.StringAttribute{
+							Description: "The access control list, a comma-separated list of networks in CIDR notation that are allowed to transfer the zone (AXFR).",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Specifies if the zone is active or not.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Zone type. E.g. `primary` or `secondary`.",
+							Computed:    true,
+						},
+						"is_reverse_zone": schema.BoolAttribute{
+							Description: "Specifies, if the zone is a reverse zone or not.",
+							Computed:    true,
+						},
+						"primary_name_server": schema.StringAttribute{
+							Description: "Primary name server. FQDN.",
+							Computed:    true,
+						},
+						"record_count": schema.Int64Attribute{
+							Description: "Record count how many records are in the zone.",
+							Computed:    true,
+						},
+						"visibility": schema.StringAttribute{
+							Description: "Visibility of the zone.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Zone state.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *zonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state Model
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	projectId := state.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	zones := []dns.Zone{}
+	for page := int32(1); ; page++ {
+		listReq := d.client.GetZones(ctx, projectId).Page(page).PageSize(pageSize)
+		if !state.Name.IsNull() {
+			listReq = listReq.NameEq(state.Name.ValueString())
+		}
+		if !state.Active.IsNull() {
+			listReq = listReq.ActiveEq(state.Active.ValueBool())
+		}
+		if !state.State.IsNull() {
+			listReq = listReq.StateEq(state.State.ValueString())
+		} else {
+			listReq = listReq.StateNeq(dns.DeleteSuccess)
+		}
+		listResp, err := listReq.Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to list zones", fmt.Sprintf("Calling API for page %d: %v", page, err))
+			return
+		}
+		if listResp.Zones != nil {
+			zones = append(zones, *listResp.Zones...)
+		}
+		if listResp.TotalPages == nil || page >= *listResp.TotalPages {
+			break
+		}
+	}
+
+	err := mapFields(zones, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "DNS zones read")
+}
+
+func mapFields(zones []dns.Zone, model *Model) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	model.Zones = []Zone{}
+	for _, z := range zones {
+		if z.Id == nil {
+			return fmt.Errorf("zone id not present")
+		}
+		model.Zones = append(model.Zones, Zone{
+			ZoneId:            types.StringPointerValue(z.Id),
+			Name:              types.StringPointerValue(z.Name),
+			DnsName:           types.StringPointerValue(z.DnsName),
+			Description:       types.StringPointerValue(z.Description),
+			Acl:               types.StringPointerValue(z.Acl),
+			Active:            types.BoolPointerValue(z.Active),
+			Type:              types.StringPointerValue(z.Type),
+			IsReverseZone:     types.BoolPointerValue(z.IsReverseZone),
+			PrimaryNameServer: types.StringPointerValue(z.PrimaryNameServer),
+			RecordCount:       conversion.ToTypeInt64(z.RecordCount),
+			Visibility:        types.StringPointerValue(z.Visibility),
+			State:             types.StringPointerValue(z.State),
+		})
+	}
+	return nil
+}
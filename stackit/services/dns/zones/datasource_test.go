@@ -0,0 +1,117 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []dns.Zone
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"no_zones",
+			[]dns.Zone{},
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Zones:     []Zone{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			[]dns.Zone{
+				{
+					Id:                utils.Ptr("zid-1"),
+					Name:              utils.Ptr("name"),
+					DnsName:           utils.Ptr("example.com"),
+					Description:       utils.Ptr("description"),
+					Acl:               utils.Ptr("0.0.0.0/0,::/0"),
+					Active:            utils.Ptr(true),
+					Type:              utils.Ptr("primary"),
+					IsReverseZone:     utils.Ptr(false),
+					PrimaryNameServer: utils.Ptr("ns1.example.com"),
+					RecordCount:       utils.Ptr(int32(4)),
+					Visibility:        utils.Ptr("public"),
+					State:             utils.Ptr("CREATE_SUCCEEDED"),
+				},
+				{
+					Id: utils.Ptr("zid-2"),
+				},
+			},
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Zones: []Zone{
+					{
+						ZoneId:            types.StringValue("zid-1"),
+						Name:              types.StringValue("name"),
+						DnsName:           types.StringValue("example.com"),
+						Description:       types.StringValue("description"),
+						Acl:               types.StringValue("0.0.0.0/0,::/0"),
+						Active:            types.BoolValue(true),
+						Type:              types.StringValue("primary"),
+						IsReverseZone:     types.BoolValue(false),
+						PrimaryNameServer: types.StringValue("ns1.example.com"),
+						RecordCount:       types.Int64Value(4),
+						Visibility:        types.StringValue("public"),
+						State:             types.StringValue("CREATE_SUCCEEDED"),
+					},
+					{
+						ZoneId:            types.StringValue("zid-2"),
+						Name:              types.StringNull(),
+						DnsName:           types.StringNull(),
+						Description:       types.StringNull(),
+						Acl:               types.StringNull(),
+						Active:            types.BoolNull(),
+						Type:              types.StringNull(),
+						IsReverseZone:     types.BoolNull(),
+						PrimaryNameServer: types.StringNull(),
+						RecordCount:       types.Int64Null(),
+						Visibility:        types.StringNull(),
+						State:             types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_zone_id",
+			[]dns.Zone{
+				{
+					Name: utils.Ptr("name"),
+				},
+			},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapFields(tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -3,13 +3,13 @@ package logme
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -28,21 +28,26 @@ var (
 	_ resource.Resource                = &logmeCredentialsResource{}
 	_ resource.ResourceWithConfigure   = &logmeCredentialsResource{}
 	_ resource.ResourceWithImportState = &logmeCredentialsResource{}
+	_ resource.ResourceWithModifyPlan  = &logmeCredentialsResource{}
 )
 
 type Model struct {
-	Id            types.String `tfsdk:"id"` // needed by TF
-	CredentialsId types.String `tfsdk:"credentials_id"`
-	InstanceId    types.String `tfsdk:"instance_id"`
-	ProjectId     types.String `tfsdk:"project_id"`
-	Host          types.String `tfsdk:"host"`
-	Hosts         types.List   `tfsdk:"hosts"`
-	HttpAPIURI    types.String `tfsdk:"http_api_uri"`
-	Name          types.String `tfsdk:"name"`
-	Password      types.String `tfsdk:"password"`
-	Port          types.Int64  `tfsdk:"port"`
-	Uri           types.String `tfsdk:"uri"`
-	Username      types.String `tfsdk:"username"`
+	Id             types.String `tfsdk:"id"` // needed by TF
+	CredentialsId  types.String `tfsdk:"credentials_id"`
+	InstanceId     types.String `tfsdk:"instance_id"`
+	ProjectId      types.String `tfsdk:"project_id"`
+	Host           types.String `tfsdk:"host"`
+	Hosts          types.List   `tfsdk:"hosts"`
+	HttpAPIURI     types.String `tfsdk:"http_api_uri"`
+	Name           types.String `tfsdk:"name"`
+	Password       types.String `tfsdk:"password"`
+	Port           types.Int64  `tfsdk:"port"`
+	SyslogDrainUrl types.String `tfsdk:"syslog_drain_url"`
+	Uri            types.String `tfsdk:"uri"`
+	Username       types.String `tfsdk:"username"`
+
+	RotateAfter types.String `tfsdk:"rotate_after"`
+	CreatedAt   types.String `tfsdk:"created_at"`
 }
 
 // NewlogmeCredentialsResource is a helper function to simplify the provider implementation.
@@ -52,7 +57,8 @@ func NewlogmeCredentialsResource() resource.Resource {
 
 // credentialsResource is the resource implementation.
 type logmeCredentialsResource struct {
-	client *logme.APIClient
+	client          *logme.APIClient
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -77,12 +83,12 @@ func (r *logmeCredentialsResource) Configure(ctx context.Context, req resource.C
 	var err error
 	if providerData.LogMeCustomEndpoint != "" {
 		apiClient, err = logme.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("logme")),
 			config.WithEndpoint(providerData.LogMeCustomEndpoint),
 		)
 	} else {
 		apiClient, err = logme.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("logme")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -94,6 +100,7 @@ func (r *logmeCredentialsResource) Configure(ctx context.Context, req resource.C
 
 	tflog.Info(ctx, "logme zone client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
 }
 
 // Schema defines the schema for the resource.
@@ -164,23 +171,26 @@ func (r *logmeCredentialsResource) Schema(_ context.Context, _ resource.SchemaRe
 			"name": schema.StringAttribute{
 				Computed: true,
 			},
-			"password": schema.StringAttribute{
-				Computed:  true,
-				Sensitive: true,
-			},
+			"password": schemas.SensitiveComputedString(""),
 			"port": schema.Int64Attribute{
 				Computed: true,
 			},
-			"uri": schema.StringAttribute{
-				Computed: true,
-			},
+			"syslog_drain_url": schemas.SensitiveComputedString("URL of the syslog drain of the instance, to which applications can ship their logs."),
+			"uri":              schemas.SensitiveComputedString(""),
 			"username": schema.StringAttribute{
 				Computed: true,
 			},
+			"rotate_after": schemas.RotateAfter(),
+			"created_at":   schemas.CreatedAt(),
 		},
 	}
 }
 
+// ModifyPlan rotates the credentials if they are older than rotate_after.
+func (r *logmeCredentialsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	core.RotateCredentials(ctx, req, resp, time.Now())
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *logmeCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
@@ -207,9 +217,9 @@ func (r *logmeCredentialsResource) Create(ctx context.Context, req resource.Crea
 	credentialsId := *credentialsResp.Id
 	ctx = tflog.SetField(ctx, "credentials_id", credentialsId)
 
-	wr, err := logme.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	wr, err := logme.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(r.defaultTimeouts.CreateOr(1 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credentials", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credentials", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*logme.CredentialsResponse)
@@ -224,6 +234,7 @@ func (r *logmeCredentialsResource) Create(ctx context.Context, req resource.Crea
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	model.CreatedAt = core.CreatedAt(time.Now())
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	tflog.Info(ctx, "LogMe credentials created")
@@ -257,6 +268,11 @@ func (r *logmeCredentialsResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	// Credentials that were imported or created by earlier provider versions have no creation time yet
+	if model.CreatedAt.IsNull() {
+		model.CreatedAt = core.CreatedAt(time.Now())
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -264,9 +280,25 @@ func (r *logmeCredentialsResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (r *logmeCredentialsResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// Update shouldn't be called
-	resp.Diagnostics.AddError("Error updating credentials", "credentials can't be updated")
+func (r *logmeCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Only rotate_after can be updated, all other attributes require the replacement of the credentials.
+	// The credentials are kept as they are in the state, as they are unknown in the plan
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var plan Model
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.RotateAfter = plan.RotateAfter
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "LogMe credentials updated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -290,9 +322,9 @@ func (r *logmeCredentialsResource) Delete(ctx context.Context, req resource.Dele
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credentials", err.Error())
 	}
-	_, err = logme.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	_, err = logme.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(r.defaultTimeouts.DeleteOr(1 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credentials", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credentials", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "LogMe credentials deleted")
@@ -301,7 +333,7 @@ func (r *logmeCredentialsResource) Delete(ctx context.Context, req resource.Dele
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id,credentials_id
 func (r *logmeCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Unexpected Import Identifier",
@@ -343,9 +375,10 @@ func mapFields(credentialsResp *logme.CredentialsResponse, model *Model) error {
 		credentialsId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.CredentialsId = types.StringValue(credentialsId)
+	model.SyslogDrainUrl = conversion.ToOptionalString(credentialsResp.Raw.SyslogDrainUrl)
 	model.Hosts = types.ListNull(types.StringType)
 	if credentials != nil {
 		if credentials.Hosts != nil {
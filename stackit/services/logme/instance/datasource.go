@@ -52,12 +52,12 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	var err error
 	if providerData.LogMeCustomEndpoint != "" {
 		apiClient, err = logme.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("logme")),
 			config.WithEndpoint(providerData.LogMeCustomEndpoint),
 		)
 	} else {
 		apiClient, err = logme.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("logme")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -95,7 +95,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["instance_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -103,7 +103,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["project_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -158,6 +158,9 @@ func (r *instanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.InstanceId = core.CanonicalizeIDValue(state.InstanceId)
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
 
 	projectId := state.ProjectId.ValueString()
 	instanceId := state.InstanceId.ValueString()
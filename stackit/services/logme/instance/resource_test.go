@@ -302,3 +302,82 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestMapPlanNameAndVersion(t *testing.T) {
+	offerings := &logme.OfferingList{
+		Offerings: &[]logme.Offering{
+			{
+				Version: utils.Ptr("1"),
+				Plans: &[]logme.Plan{
+					{Id: utils.Ptr("pid-1"), Name: utils.Ptr("plan-1")},
+				},
+			},
+			{
+				Version: utils.Ptr("2"),
+				Plans: &[]logme.Plan{
+					{Id: nil, Name: utils.Ptr("no-id")},
+					{Id: utils.Ptr("pid-2"), Name: utils.Ptr("plan-2")},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		description string
+		planId      string
+		input       *logme.OfferingList
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"ok",
+			"pid-2",
+			offerings,
+			Model{
+				PlanId:   types.StringValue("pid-2"),
+				PlanName: types.StringValue("plan-2"),
+				Version:  types.StringValue("2"),
+			},
+			true,
+		},
+		{
+			"plan_not_found",
+			"pid-3",
+			offerings,
+			Model{},
+			false,
+		},
+		{
+			"offerings_nil",
+			"pid-1",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				PlanId:   types.StringValue(tt.planId),
+				PlanName: types.StringNull(),
+				Version:  types.StringNull(),
+			}
+			err := mapPlanNameAndVersion(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model.PlanName, tt.expected.PlanName)
+				if diff != "" {
+					t.Fatalf("Plan name does not match: %s", diff)
+				}
+				diff = cmp.Diff(model.Version, tt.expected.Version)
+				if diff != "" {
+					t.Fatalf("Version does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -93,6 +93,11 @@ func TestAccLogMeResource(t *testing.T) {
 						project_id     = stackit_logme_credentials.credentials.project_id
 						instance_id    = stackit_logme_credentials.credentials.instance_id
 					    credentials_id = stackit_logme_credentials.credentials.credentials_id
+					}
+
+					data "stackit_logme_instances" "instances" {
+						project_id = stackit_logme_instance.instance.project_id
+						name       = stackit_logme_instance.instance.name
 					}`,
 					resourceConfig(instanceResource["sgw_acl-1"]),
 				),
@@ -117,6 +122,13 @@ func TestAccLogMeResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.stackit_logme_credentials.credentials", "host"),
 					resource.TestCheckResourceAttrSet("data.stackit_logme_credentials.credentials", "port"),
 					resource.TestCheckResourceAttrSet("data.stackit_logme_credentials.credentials", "uri"),
+
+					// Instances data
+					resource.TestCheckResourceAttr("data.stackit_logme_instances.instances", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("stackit_logme_instance.instance", "instance_id",
+						"data.stackit_logme_instances.instances", "instances.0.instance_id"),
+					resource.TestCheckResourceAttr("data.stackit_logme_instances.instances", "instances.0.plan_id", instanceResource["plan_id"]),
+					resource.TestCheckResourceAttrSet("data.stackit_logme_instances.instances", "instances.0.plan_name"),
 				),
 			},
 			// Import
@@ -195,7 +207,7 @@ func testAccCheckLogMeDestroy(s *terraform.State) error {
 			continue
 		}
 		// instance terraform ID: "[project_id],[instance_id]"
-		instanceId := strings.Split(rs.Primary.ID, core.Separator)[1]
+		instanceId := core.SplitID(rs.Primary.ID)[1]
 		instancesToDestroy = append(instancesToDestroy, instanceId)
 	}
 
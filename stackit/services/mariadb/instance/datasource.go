@@ -52,12 +52,12 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	var err error
 	if providerData.MariaDBCustomEndpoint != "" {
 		apiClient, err = mariadb.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("mariadb")),
 			config.WithEndpoint(providerData.MariaDBCustomEndpoint),
 		)
 	} else {
 		apiClient, err = mariadb.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("mariadb")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -95,7 +95,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["instance_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -103,7 +103,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["project_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -125,6 +125,18 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"parameters": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
+					"enable_monitoring": schema.BoolAttribute{
+						Computed: true,
+					},
+					"metrics_frequency": schema.Int64Attribute{
+						Computed: true,
+					},
+					"metrics_prefix": schema.StringAttribute{
+						Computed: true,
+					},
+					"monitoring_instance_id": schema.StringAttribute{
+						Computed: true,
+					},
 					"sgw_acl": schema.StringAttribute{
 						Computed: true,
 					},
@@ -158,6 +170,9 @@ func (r *instanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.InstanceId = core.CanonicalizeIDValue(state.InstanceId)
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
 
 	projectId := state.ProjectId.ValueString()
 	instanceId := state.InstanceId.ValueString()
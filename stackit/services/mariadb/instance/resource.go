@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 
@@ -25,11 +26,16 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/mariadb"
 )
 
+// serviceEnablementHint describes how MariaDB is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate MariaDB for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &instanceResource{}
-	_ resource.ResourceWithConfigure   = &instanceResource{}
-	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.Resource                   = &instanceResource{}
+	_ resource.ResourceWithConfigure      = &instanceResource{}
+	_ resource.ResourceWithImportState    = &instanceResource{}
+	_ resource.ResourceWithModifyPlan     = &instanceResource{}
+	_ resource.ResourceWithValidateConfig = &instanceResource{}
 )
 
 type Model struct {
@@ -48,14 +54,25 @@ type Model struct {
 	PlanId             types.String `tfsdk:"plan_id"`
 }
 
+// replacementComputedAttributes are the computed attributes whose values change if the instance is replaced.
+var replacementComputedAttributes = []string{"instance_id", "cf_guid", "cf_space_guid", "cf_organization_guid", "dashboard_url"}
+
 // Struct corresponding to DataSourceModel.Parameters
 type parametersModel struct {
-	SgwAcl types.String `tfsdk:"sgw_acl"`
+	EnableMonitoring     types.Bool   `tfsdk:"enable_monitoring"`
+	MetricsFrequency     types.Int64  `tfsdk:"metrics_frequency"`
+	MetricsPrefix        types.String `tfsdk:"metrics_prefix"`
+	MonitoringInstanceId types.String `tfsdk:"monitoring_instance_id"`
+	SgwAcl               types.String `tfsdk:"sgw_acl"`
 }
 
 // Types corresponding to parametersModel
 var parametersTypes = map[string]attr.Type{
-	"sgw_acl": basetypes.StringType{},
+	"enable_monitoring":      basetypes.BoolType{},
+	"metrics_frequency":      basetypes.Int64Type{},
+	"metrics_prefix":         basetypes.StringType{},
+	"monitoring_instance_id": basetypes.StringType{},
+	"sgw_acl":                basetypes.StringType{},
 }
 
 // NewInstanceResource is a helper function to simplify the provider implementation.
@@ -65,7 +82,9 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *mariadb.APIClient
+	client          *mariadb.APIClient
+	strict          bool
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -90,12 +109,12 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	var err error
 	if providerData.MariaDBCustomEndpoint != "" {
 		apiClient, err = mariadb.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("mariadb")),
 			config.WithEndpoint(providerData.MariaDBCustomEndpoint),
 		)
 	} else {
 		apiClient, err = mariadb.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("mariadb")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -107,6 +126,8 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 
 	tflog.Info(ctx, "mariadb zone client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
+	r.strict = providerData.Strict
 }
 
 // Schema defines the schema for the resource.
@@ -177,9 +198,30 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"parameters": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
-					"sgw_acl": schema.StringAttribute{
+					"enable_monitoring": schema.BoolAttribute{
+						Description: "Enables the monitoring of the instance by the Argus instance set in `monitoring_instance_id`.",
+						Optional:    true,
+					},
+					"metrics_frequency": schema.Int64Attribute{
+						Optional: true,
+					},
+					"metrics_prefix": schema.StringAttribute{
 						Optional: true,
-						Computed: true,
+					},
+					"monitoring_instance_id": schema.StringAttribute{
+						Description: "ID of the Argus instance, in the same project, which monitors the instance. Required if `enable_monitoring` is true.",
+						Optional:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+						},
+					},
+					"sgw_acl": schema.StringAttribute{
+						Description: "Comma separated list of IP networks in CIDR notation which are allowed to access this instance. E.g. `192.168.0.0/24,10.0.0.0/8`",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							validate.SgwACL(),
+						},
 					},
 				},
 				Optional: true,
@@ -204,6 +246,39 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+// ValidateConfig validates the resource configuration.
+func (r *instanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var parameters types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	if resp.Diagnostics.HasError() || parameters.IsNull() || parameters.IsUnknown() {
+		return
+	}
+	var parametersValues parametersModel
+	resp.Diagnostics.Append(parameters.As(ctx, &parametersValues, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkMonitoringConfig(&parametersValues)...)
+}
+
+// checkMonitoringConfig validates that a monitoring instance is set if monitoring is enabled.
+func checkMonitoringConfig(parameters *parametersModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !parameters.EnableMonitoring.ValueBool() {
+		return diags
+	}
+	if parameters.MonitoringInstanceId.IsNull() {
+		diags.AddAttributeError(path.Root("parameters").AtName("monitoring_instance_id"), "Missing monitoring instance",
+			"monitoring_instance_id must be set to the ID of an Argus instance if enable_monitoring is true")
+	}
+	return diags
+}
+
+// ModifyPlan warns if the instance is replaced, as resources referencing its computed attributes change as well.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	core.WarnOnReplacement(ctx, req, resp, r.strict, "MariaDB instance", []string{"project_id", "name"}, replacementComputedAttributes)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
@@ -239,14 +314,21 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new instance
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*payload).Execute()
 	if err != nil {
+		err = core.ServiceEnablementError(err, "MariaDB", projectId, serviceEnablementHint)
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
 	instanceId := *createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	wr, err := mariadb.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	wr, err := mariadb.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.CreateOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
+		// Track the instance, so it isn't left behind if the creation was interrupted or timed out
+		resp.Diagnostics.Append(core.SavePartialState(ctx, &resp.State, map[string]string{
+			"id":          core.JoinID(projectId, instanceId),
+			"project_id":  projectId,
+			"instance_id": instanceId,
+		})...)
 		return
 	}
 	got, ok := wr.(*mariadb.Instance)
@@ -292,6 +374,14 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	// The plan name and version aren't returned with the instance. If they are unknown, e.g. after an import,
+	// they are resolved from the plan ID, so that configurations generated by "terraform plan -generate-config-out" are valid
+	if state.PlanName.IsNull() || state.Version.IsNull() {
+		err = r.loadPlanNameAndVersion(ctx, &state)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Resolving plan name and version: %v", err))
+		}
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -338,9 +428,9 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", err.Error())
 		return
 	}
-	wr, err := mariadb.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	wr, err := mariadb.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.UpdateOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*mariadb.Instance)
@@ -379,9 +469,9 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", err.Error())
 		return
 	}
-	_, err = mariadb.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	_, err = mariadb.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.DeleteOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "mariadb instance deleted")
@@ -390,7 +480,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -427,7 +517,7 @@ func mapFields(instance *mariadb.Instance, model *Model) error {
 		instanceId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.InstanceId = types.StringValue(instanceId)
 	model.PlanId = types.StringPointerValue(instance.PlanId)
@@ -555,7 +645,12 @@ func toCreatePayload(model *Model, parameters *parametersModel) (*mariadb.Create
 			PlanId:       model.PlanId.ValueStringPointer(),
 		}, nil
 	}
-	payloadParams := &mariadb.InstanceParameters{}
+	payloadParams := &mariadb.InstanceParameters{
+		EnableMonitoring:     parameters.EnableMonitoring.ValueBoolPointer(),
+		MetricsFrequency:     conversion.ToPtrInt32(parameters.MetricsFrequency),
+		MetricsPrefix:        parameters.MetricsPrefix.ValueStringPointer(),
+		MonitoringInstanceId: parameters.MonitoringInstanceId.ValueStringPointer(),
+	}
 	if parameters.SgwAcl.ValueString() != "" {
 		payloadParams.SgwAcl = parameters.SgwAcl.ValueStringPointer()
 	}
@@ -578,7 +673,11 @@ func toUpdatePayload(model *Model, parameters *parametersModel) (*mariadb.Update
 	}
 	return &mariadb.UpdateInstancePayload{
 		Parameters: &mariadb.InstanceParameters{
-			SgwAcl: parameters.SgwAcl.ValueStringPointer(),
+			EnableMonitoring:     parameters.EnableMonitoring.ValueBoolPointer(),
+			MetricsFrequency:     conversion.ToPtrInt32(parameters.MetricsFrequency),
+			MetricsPrefix:        parameters.MetricsPrefix.ValueStringPointer(),
+			MonitoringInstanceId: parameters.MonitoringInstanceId.ValueStringPointer(),
+			SgwAcl:               parameters.SgwAcl.ValueStringPointer(),
 		},
 		PlanId: model.PlanId.ValueStringPointer(),
 	}, nil
@@ -622,3 +721,32 @@ func (r *instanceResource) loadPlanId(ctx context.Context, diags *diag.Diagnosti
 	}
 	diags.AddError("Invalid plan_name", fmt.Sprintf("Couldn't find plan_name '%s' for version %s, available names are:%s", planName, version, availablePlanNames))
 }
+
+// loadPlanNameAndVersion sets the plan name and version of the model from the offering matching its plan ID.
+func (r *instanceResource) loadPlanNameAndVersion(ctx context.Context, model *Model) error {
+	res, err := r.client.GetOfferings(ctx, model.ProjectId.ValueString()).Execute()
+	if err != nil {
+		return fmt.Errorf("listing offerings: %w", err)
+	}
+	return mapPlanNameAndVersion(res, model)
+}
+
+func mapPlanNameAndVersion(offerings *mariadb.OfferingList, model *Model) error {
+	if offerings == nil || offerings.Offerings == nil {
+		return fmt.Errorf("offerings input is nil")
+	}
+	planId := model.PlanId.ValueString()
+	for _, offer := range *offerings.Offerings {
+		if offer.Plans == nil {
+			continue
+		}
+		for _, plan := range *offer.Plans {
+			if plan.Id != nil && *plan.Id == planId {
+				model.PlanName = types.StringPointerValue(plan.Name)
+				model.Version = types.StringPointerValue(offer.Version)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("plan %s not found in the offerings", planId)
+}
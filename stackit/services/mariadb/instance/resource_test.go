@@ -47,7 +47,11 @@ func TestMapFields(t *testing.T) {
 				Name:               utils.Ptr("name"),
 				CfOrganizationGuid: utils.Ptr("org"),
 				Parameters: &map[string]interface{}{
-					"sgw_acl": "acl",
+					"enable_monitoring":      true,
+					"metrics_frequency":      1234,
+					"metrics_prefix":         "prefix",
+					"monitoring_instance_id": "mid",
+					"sgw_acl":                "acl",
 				},
 			},
 			Model{
@@ -62,7 +66,11 @@ func TestMapFields(t *testing.T) {
 				ImageUrl:           types.StringValue("image"),
 				CfOrganizationGuid: types.StringValue("org"),
 				Parameters: types.ObjectValueMust(parametersTypes, map[string]attr.Value{
-					"sgw_acl": types.StringValue("acl"),
+					"enable_monitoring":      types.BoolValue(true),
+					"metrics_frequency":      types.Int64Value(1234),
+					"metrics_prefix":         types.StringValue("prefix"),
+					"monitoring_instance_id": types.StringValue("mid"),
+					"sgw_acl":                types.StringValue("acl"),
 				}),
 			},
 			true,
@@ -147,12 +155,20 @@ func TestToCreatePayload(t *testing.T) {
 				PlanId: types.StringValue("plan"),
 			},
 			&parametersModel{
-				SgwAcl: types.StringValue("sgw"),
+				EnableMonitoring:     types.BoolValue(true),
+				MetricsFrequency:     types.Int64Value(123),
+				MetricsPrefix:        types.StringValue("prefix"),
+				MonitoringInstanceId: types.StringValue("mid"),
+				SgwAcl:               types.StringValue("sgw"),
 			},
 			&mariadb.CreateInstancePayload{
 				InstanceName: utils.Ptr("name"),
 				Parameters: &mariadb.InstanceParameters{
-					SgwAcl: utils.Ptr("sgw"),
+					EnableMonitoring:     utils.Ptr(true),
+					MetricsFrequency:     utils.Ptr(int32(123)),
+					MetricsPrefix:        utils.Ptr("prefix"),
+					MonitoringInstanceId: utils.Ptr("mid"),
+					SgwAcl:               utils.Ptr("sgw"),
 				},
 				PlanId: utils.Ptr("plan"),
 			},
@@ -239,11 +255,19 @@ func TestToUpdatePayload(t *testing.T) {
 				PlanId: types.StringValue("plan"),
 			},
 			&parametersModel{
-				SgwAcl: types.StringValue("sgw"),
+				EnableMonitoring:     types.BoolValue(true),
+				MetricsFrequency:     types.Int64Value(123),
+				MetricsPrefix:        types.StringValue("prefix"),
+				MonitoringInstanceId: types.StringValue("mid"),
+				SgwAcl:               types.StringValue("sgw"),
 			},
 			&mariadb.UpdateInstancePayload{
 				Parameters: &mariadb.InstanceParameters{
-					SgwAcl: utils.Ptr("sgw"),
+					EnableMonitoring:     utils.Ptr(true),
+					MetricsFrequency:     utils.Ptr(int32(123)),
+					MetricsPrefix:        utils.Ptr("prefix"),
+					MonitoringInstanceId: utils.Ptr("mid"),
+					SgwAcl:               utils.Ptr("sgw"),
 				},
 				PlanId: utils.Ptr("plan"),
 			},
@@ -302,3 +326,122 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckMonitoringConfig(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *parametersModel
+		isValid     bool
+	}{
+		{
+			"monitoring_disabled",
+			&parametersModel{EnableMonitoring: types.BoolNull(), MonitoringInstanceId: types.StringNull()},
+			true,
+		},
+		{
+			"monitoring_enabled",
+			&parametersModel{EnableMonitoring: types.BoolValue(true), MonitoringInstanceId: types.StringValue("mid")},
+			true,
+		},
+		{
+			"monitoring_instance_unknown",
+			&parametersModel{EnableMonitoring: types.BoolValue(true), MonitoringInstanceId: types.StringUnknown()},
+			true,
+		},
+		{
+			"monitoring_instance_missing",
+			&parametersModel{EnableMonitoring: types.BoolValue(true), MonitoringInstanceId: types.StringNull()},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkMonitoringConfig(tt.input)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestMapPlanNameAndVersion(t *testing.T) {
+	offerings := &mariadb.OfferingList{
+		Offerings: &[]mariadb.Offering{
+			{
+				Version: utils.Ptr("1"),
+				Plans: &[]mariadb.Plan{
+					{Id: utils.Ptr("pid-1"), Name: utils.Ptr("plan-1")},
+				},
+			},
+			{
+				Version: utils.Ptr("2"),
+				Plans: &[]mariadb.Plan{
+					{Id: nil, Name: utils.Ptr("no-id")},
+					{Id: utils.Ptr("pid-2"), Name: utils.Ptr("plan-2")},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		description string
+		planId      string
+		input       *mariadb.OfferingList
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"ok",
+			"pid-2",
+			offerings,
+			Model{
+				PlanId:   types.StringValue("pid-2"),
+				PlanName: types.StringValue("plan-2"),
+				Version:  types.StringValue("2"),
+			},
+			true,
+		},
+		{
+			"plan_not_found",
+			"pid-3",
+			offerings,
+			Model{},
+			false,
+		},
+		{
+			"offerings_nil",
+			"pid-1",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				PlanId:   types.StringValue(tt.planId),
+				PlanName: types.StringNull(),
+				Version:  types.StringNull(),
+			}
+			err := mapPlanNameAndVersion(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model.PlanName, tt.expected.PlanName)
+				if diff != "" {
+					t.Fatalf("Plan name does not match: %s", diff)
+				}
+				diff = cmp.Diff(model.Version, tt.expected.Version)
+				if diff != "" {
+					t.Fatalf("Version does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
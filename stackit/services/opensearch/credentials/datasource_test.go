@@ -0,0 +1,11 @@
+package opensearch
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/testutil/modeltest"
+)
+
+func TestToDataSourceModel(t *testing.T) {
+	modeltest.CheckDataSourceModel(t, toDataSourceModel, "created_at", "rotate_after")
+}
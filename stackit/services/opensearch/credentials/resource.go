@@ -3,13 +3,13 @@ package opensearch
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                = &openSearchCredentialsResource{}
 	_ resource.ResourceWithConfigure   = &openSearchCredentialsResource{}
 	_ resource.ResourceWithImportState = &openSearchCredentialsResource{}
+	_ resource.ResourceWithModifyPlan  = &openSearchCredentialsResource{}
 )
 
 type Model struct {
@@ -43,6 +44,9 @@ type Model struct {
 	Port          types.Int64  `tfsdk:"port"`
 	Uri           types.String `tfsdk:"uri"`
 	Username      types.String `tfsdk:"username"`
+
+	RotateAfter types.String `tfsdk:"rotate_after"`
+	CreatedAt   types.String `tfsdk:"created_at"`
 }
 
 // NewCredentialsResource is a helper function to simplify the provider implementation.
@@ -52,7 +56,8 @@ func NewCredentialsResource() resource.Resource {
 
 // credentialsResource is the resource implementation.
 type openSearchCredentialsResource struct {
-	client *opensearch.APIClient
+	client          *opensearch.APIClient
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -77,12 +82,12 @@ func (r *openSearchCredentialsResource) Configure(ctx context.Context, req resou
 	var err error
 	if providerData.OpenSearchCustomEndpoint != "" {
 		apiClient, err = opensearch.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("opensearch")),
 			config.WithEndpoint(providerData.OpenSearchCustomEndpoint),
 		)
 	} else {
 		apiClient, err = opensearch.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("opensearch")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -94,6 +99,7 @@ func (r *openSearchCredentialsResource) Configure(ctx context.Context, req resou
 
 	tflog.Info(ctx, "OpenSearch zone client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
 }
 
 // Schema defines the schema for the resource.
@@ -164,23 +170,25 @@ func (r *openSearchCredentialsResource) Schema(_ context.Context, _ resource.Sch
 			"name": schema.StringAttribute{
 				Computed: true,
 			},
-			"password": schema.StringAttribute{
-				Computed:  true,
-				Sensitive: true,
-			},
+			"password": schemas.SensitiveComputedString(""),
 			"port": schema.Int64Attribute{
 				Computed: true,
 			},
-			"uri": schema.StringAttribute{
-				Computed: true,
-			},
+			"uri": schemas.SensitiveComputedString(""),
 			"username": schema.StringAttribute{
 				Computed: true,
 			},
+			"rotate_after": schemas.RotateAfter(),
+			"created_at":   schemas.CreatedAt(),
 		},
 	}
 }
 
+// ModifyPlan rotates the credentials if they are older than rotate_after.
+func (r *openSearchCredentialsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	core.RotateCredentials(ctx, req, resp, time.Now())
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *openSearchCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
@@ -207,9 +215,9 @@ func (r *openSearchCredentialsResource) Create(ctx context.Context, req resource
 	credentialsId := *credentialsResp.Id
 	ctx = tflog.SetField(ctx, "credentials_id", credentialsId)
 
-	wr, err := opensearch.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	wr, err := opensearch.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(r.defaultTimeouts.CreateOr(1 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credentials", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credentials", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*opensearch.CredentialsResponse)
@@ -224,6 +232,7 @@ func (r *openSearchCredentialsResource) Create(ctx context.Context, req resource
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	model.CreatedAt = core.CreatedAt(time.Now())
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	tflog.Info(ctx, "OpenSearch credentials created")
@@ -257,6 +266,11 @@ func (r *openSearchCredentialsResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	// Credentials that were imported or created by earlier provider versions have no creation time yet
+	if model.CreatedAt.IsNull() {
+		model.CreatedAt = core.CreatedAt(time.Now())
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -264,9 +278,25 @@ func (r *openSearchCredentialsResource) Read(ctx context.Context, req resource.R
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (r *openSearchCredentialsResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// Update shouldn't be called
-	resp.Diagnostics.AddError("Error updating credentials", "credentials can't be updated")
+func (r *openSearchCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Only rotate_after can be updated, all other attributes require the replacement of the credentials.
+	// The credentials are kept as they are in the state, as they are unknown in the plan
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var plan Model
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.RotateAfter = plan.RotateAfter
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "OpenSearch credentials updated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -290,9 +320,9 @@ func (r *openSearchCredentialsResource) Delete(ctx context.Context, req resource
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credentials", err.Error())
 	}
-	_, err = opensearch.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	_, err = opensearch.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(r.defaultTimeouts.DeleteOr(1 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credentials", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credentials", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "OpenSearch credentials deleted")
@@ -301,7 +331,7 @@ func (r *openSearchCredentialsResource) Delete(ctx context.Context, req resource
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id,credentials_id
 func (r *openSearchCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Unexpected Import Identifier",
@@ -343,7 +373,7 @@ func mapFields(credentialsResp *opensearch.CredentialsResponse, model *Model) er
 		credentialsId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.CredentialsId = types.StringValue(credentialsId)
 	model.Hosts = types.ListNull(types.StringType)
@@ -25,11 +25,15 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/opensearch"
 )
 
+// serviceEnablementHint describes how OpenSearch is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate OpenSearch for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 )
 
 type Model struct {
@@ -48,6 +52,9 @@ type Model struct {
 	PlanId             types.String `tfsdk:"plan_id"`
 }
 
+// replacementComputedAttributes are the computed attributes whose values change if the instance is replaced.
+var replacementComputedAttributes = []string{"instance_id", "cf_guid", "cf_space_guid", "cf_organization_guid", "dashboard_url"}
+
 // Struct corresponding to DataSourceModel.Parameters
 type parametersModel struct {
 	SgwAcl types.String `tfsdk:"sgw_acl"`
@@ -65,7 +72,9 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *opensearch.APIClient
+	client          *opensearch.APIClient
+	strict          bool
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -90,12 +99,12 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	var err error
 	if providerData.OpenSearchCustomEndpoint != "" {
 		apiClient, err = opensearch.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("opensearch")),
 			config.WithEndpoint(providerData.OpenSearchCustomEndpoint),
 		)
 	} else {
 		apiClient, err = opensearch.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("opensearch")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -107,6 +116,8 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 
 	tflog.Info(ctx, "opensearch zone client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
+	r.strict = providerData.Strict
 }
 
 // Schema defines the schema for the resource.
@@ -178,8 +189,12 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"parameters": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"sgw_acl": schema.StringAttribute{
-						Optional: true,
-						Computed: true,
+						Description: "Comma separated list of IP networks in CIDR notation which are allowed to access this instance. E.g. `192.168.0.0/24,10.0.0.0/8`",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							validate.SgwACL(),
+						},
 					},
 				},
 				Optional: true,
@@ -204,6 +219,11 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+// ModifyPlan warns if the instance is replaced, as resources referencing its computed attributes change as well.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	core.WarnOnReplacement(ctx, req, resp, r.strict, "OpenSearch instance", []string{"project_id", "name"}, replacementComputedAttributes)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
@@ -239,14 +259,21 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new instance
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*payload).Execute()
 	if err != nil {
+		err = core.ServiceEnablementError(err, "OpenSearch", projectId, serviceEnablementHint)
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
 	instanceId := *createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	wr, err := opensearch.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	wr, err := opensearch.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.CreateOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
+		// Track the instance, so it isn't left behind if the creation was interrupted or timed out
+		resp.Diagnostics.Append(core.SavePartialState(ctx, &resp.State, map[string]string{
+			"id":          core.JoinID(projectId, instanceId),
+			"project_id":  projectId,
+			"instance_id": instanceId,
+		})...)
 		return
 	}
 	got, ok := wr.(*opensearch.Instance)
@@ -292,6 +319,14 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	// The plan name and version aren't returned with the instance. If they are unknown, e.g. after an import,
+	// they are resolved from the plan ID, so that configurations generated by "terraform plan -generate-config-out" are valid
+	if state.PlanName.IsNull() || state.Version.IsNull() {
+		err = r.loadPlanNameAndVersion(ctx, &state)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Resolving plan name and version: %v", err))
+		}
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -338,9 +373,9 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", err.Error())
 		return
 	}
-	wr, err := opensearch.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	wr, err := opensearch.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.UpdateOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*opensearch.Instance)
@@ -380,9 +415,9 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", err.Error())
 		return
 	}
-	_, err = opensearch.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	_, err = opensearch.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.DeleteOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "opensearch instance deleted")
@@ -391,7 +426,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -427,7 +462,7 @@ func mapFields(instance *opensearch.Instance, model *Model) error {
 		instanceId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.InstanceId = types.StringValue(instanceId)
 	model.PlanId = types.StringPointerValue(instance.PlanId)
@@ -621,3 +656,32 @@ func (r *instanceResource) loadPlanId(ctx context.Context, diags *diag.Diagnosti
 	}
 	diags.AddError("Invalid plan_name", fmt.Sprintf("Couldn't find plan_name '%s' for version %s, available names are:%s", planName, version, availablePlanNames))
 }
+
+// loadPlanNameAndVersion sets the plan name and version of the model from the offering matching its plan ID.
+func (r *instanceResource) loadPlanNameAndVersion(ctx context.Context, model *Model) error {
+	res, err := r.client.GetOfferings(ctx, model.ProjectId.ValueString()).Execute()
+	if err != nil {
+		return fmt.Errorf("listing offerings: %w", err)
+	}
+	return mapPlanNameAndVersion(res, model)
+}
+
+func mapPlanNameAndVersion(offerings *opensearch.OfferingList, model *Model) error {
+	if offerings == nil || offerings.Offerings == nil {
+		return fmt.Errorf("offerings input is nil")
+	}
+	planId := model.PlanId.ValueString()
+	for _, offer := range *offerings.Offerings {
+		if offer.Plans == nil {
+			continue
+		}
+		for _, plan := range *offer.Plans {
+			if plan.Id != nil && *plan.Id == planId {
+				model.PlanName = types.StringPointerValue(plan.Name)
+				model.Version = types.StringPointerValue(offer.Version)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("plan %s not found in the offerings", planId)
+}
@@ -0,0 +1,221 @@
+package opensearch
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/opensearch"
+)
+
+func TestMapFields(t *testing.T) {
+	instances := &opensearch.InstanceList{
+		Instances: &[]opensearch.Instance{
+			{
+				InstanceId:         utils.Ptr("iid-1"),
+				Name:               utils.Ptr("name-1"),
+				PlanId:             utils.Ptr("plan-id-1"),
+				DashboardUrl:       utils.Ptr("dashboard"),
+				ImageUrl:           utils.Ptr("image"),
+				CfGuid:             utils.Ptr("cf"),
+				CfSpaceGuid:        utils.Ptr("space"),
+				CfOrganizationGuid: utils.Ptr("org"),
+			},
+			{
+				InstanceId: utils.Ptr("iid-2"),
+				Name:       utils.Ptr("name-2"),
+				PlanId:     utils.Ptr("plan-id-2"),
+			},
+			{
+				InstanceId: utils.Ptr("iid-3"),
+				Name:       utils.Ptr("name-1"),
+				PlanId:     utils.Ptr("unknown-plan-id"),
+			},
+		},
+	}
+	offerings := &opensearch.OfferingList{
+		Offerings: &[]opensearch.Offering{
+			{
+				Version: utils.Ptr("12"),
+				Plans: &[]opensearch.Plan{
+					{Id: utils.Ptr("plan-id-1"), Name: utils.Ptr("plan-1")},
+				},
+			},
+			{
+				Version: utils.Ptr("13"),
+				Plans: &[]opensearch.Plan{
+					{Id: utils.Ptr("plan-id-2"), Name: utils.Ptr("plan-2")},
+					{Id: nil, Name: utils.Ptr("no-id")},
+				},
+			},
+		},
+	}
+	instance1 := Instance{
+		InstanceId:         types.StringValue("iid-1"),
+		Name:               types.StringValue("name-1"),
+		Version:            types.StringValue("12"),
+		PlanName:           types.StringValue("plan-1"),
+		PlanId:             types.StringValue("plan-id-1"),
+		DashboardUrl:       types.StringValue("dashboard"),
+		ImageUrl:           types.StringValue("image"),
+		CfGuid:             types.StringValue("cf"),
+		CfSpaceGuid:        types.StringValue("space"),
+		CfOrganizationGuid: types.StringValue("org"),
+	}
+	instance2 := Instance{
+		InstanceId:         types.StringValue("iid-2"),
+		Name:               types.StringValue("name-2"),
+		Version:            types.StringValue("13"),
+		PlanName:           types.StringValue("plan-2"),
+		PlanId:             types.StringValue("plan-id-2"),
+		DashboardUrl:       types.StringNull(),
+		ImageUrl:           types.StringNull(),
+		CfGuid:             types.StringNull(),
+		CfSpaceGuid:        types.StringNull(),
+		CfOrganizationGuid: types.StringNull(),
+	}
+	instance3 := Instance{
+		InstanceId:         types.StringValue("iid-3"),
+		Name:               types.StringValue("name-1"),
+		Version:            types.StringNull(),
+		PlanName:           types.StringNull(),
+		PlanId:             types.StringValue("unknown-plan-id"),
+		DashboardUrl:       types.StringNull(),
+		ImageUrl:           types.StringNull(),
+		CfGuid:             types.StringNull(),
+		CfSpaceGuid:        types.StringNull(),
+		CfOrganizationGuid: types.StringNull(),
+	}
+	tests := []struct {
+		description string
+		name        types.String
+		planName    types.String
+		instances   *opensearch.InstanceList
+		offerings   *opensearch.OfferingList
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			types.StringNull(),
+			types.StringNull(),
+			&opensearch.InstanceList{},
+			&opensearch.OfferingList{},
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Name:      types.StringNull(),
+				PlanName:  types.StringNull(),
+				Instances: []Instance{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			types.StringNull(),
+			types.StringNull(),
+			instances,
+			offerings,
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Name:      types.StringNull(),
+				PlanName:  types.StringNull(),
+				Instances: []Instance{instance1, instance2, instance3},
+			},
+			true,
+		},
+		{
+			"name_filter",
+			types.StringValue("name-1"),
+			types.StringNull(),
+			instances,
+			offerings,
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Name:      types.StringValue("name-1"),
+				PlanName:  types.StringNull(),
+				Instances: []Instance{instance1, instance3},
+			},
+			true,
+		},
+		{
+			"plan_name_filter",
+			types.StringNull(),
+			types.StringValue("PLAN-2"),
+			instances,
+			offerings,
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Name:      types.StringNull(),
+				PlanName:  types.StringValue("PLAN-2"),
+				Instances: []Instance{instance2},
+			},
+			true,
+		},
+		{
+			"nil_offerings",
+			types.StringNull(),
+			types.StringNull(),
+			&opensearch.InstanceList{
+				Instances: &[]opensearch.Instance{
+					{InstanceId: utils.Ptr("iid-3"), Name: utils.Ptr("name-1"), PlanId: utils.Ptr("unknown-plan-id")},
+				},
+			},
+			nil,
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Name:      types.StringNull(),
+				PlanName:  types.StringNull(),
+				Instances: []Instance{instance3},
+			},
+			true,
+		},
+		{
+			"no_instance_id",
+			types.StringNull(),
+			types.StringNull(),
+			&opensearch.InstanceList{
+				Instances: &[]opensearch.Instance{{Name: utils.Ptr("name")}},
+			},
+			offerings,
+			Model{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			types.StringNull(),
+			types.StringNull(),
+			nil,
+			offerings,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId: types.StringValue("pid"),
+				Name:      tt.name,
+				PlanName:  tt.planName,
+			}
+			err := mapFields(tt.instances, tt.offerings, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
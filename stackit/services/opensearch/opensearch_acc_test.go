@@ -115,6 +115,11 @@ func TestAccOpenSearchResource(t *testing.T) {
 						project_id     = stackit_opensearch_credentials.credentials.project_id
 						instance_id    = stackit_opensearch_credentials.credentials.instance_id
 					    credentials_id = stackit_opensearch_credentials.credentials.credentials_id
+					}
+
+					data "stackit_opensearch_instances" "instances" {
+						project_id = stackit_opensearch_instance.instance.project_id
+						name       = stackit_opensearch_instance.instance.name
 					}`,
 					resourceConfig(),
 				),
@@ -139,6 +144,13 @@ func TestAccOpenSearchResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.stackit_opensearch_credentials.credentials", "host"),
 					resource.TestCheckResourceAttrSet("data.stackit_opensearch_credentials.credentials", "port"),
 					resource.TestCheckResourceAttrSet("data.stackit_opensearch_credentials.credentials", "uri"),
+
+					// Instances data
+					resource.TestCheckResourceAttr("data.stackit_opensearch_instances.instances", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("stackit_opensearch_instance.instance", "instance_id",
+						"data.stackit_opensearch_instances.instances", "instances.0.instance_id"),
+					resource.TestCheckResourceAttr("data.stackit_opensearch_instances.instances", "instances.0.plan_id", instanceResource["plan_id"]),
+					resource.TestCheckResourceAttrSet("data.stackit_opensearch_instances.instances", "instances.0.plan_name"),
 				),
 			},
 			// Import
@@ -217,7 +229,7 @@ func testAccCheckOpenSearchDestroy(s *terraform.State) error {
 			continue
 		}
 		// instance terraform ID: "[project_id],[instance_id]"
-		instanceId := strings.Split(rs.Primary.ID, core.Separator)[1]
+		instanceId := core.SplitID(rs.Primary.ID)[1]
 		instancesToDestroy = append(instancesToDestroy, instanceId)
 	}
 
@@ -0,0 +1,239 @@
+package postgresflex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &backupsDataSource{}
+)
+
+type Model struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	ProjectId  types.String `tfsdk:"project_id"`
+	InstanceId types.String `tfsdk:"instance_id"`
+	Backups    []Backup     `tfsdk:"backups"`
+}
+
+type Backup struct {
+	BackupId  types.String `tfsdk:"backup_id"`
+	Name      types.String `tfsdk:"name"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+	Size      types.Int64  `tfsdk:"size"`
+	Error     types.String `tfsdk:"error"`
+	Labels    types.List   `tfsdk:"labels"`
+}
+
+// NewBackupsDataSource is a helper function to simplify the provider implementation.
+func NewBackupsDataSource() datasource.DataSource {
+	return &backupsDataSource{}
+}
+
+// backupsDataSource is the data source implementation.
+type backupsDataSource struct {
+	client *postgresflex.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *backupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresflex_backups"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *backupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *postgresflex.APIClient
+	var err error
+	if providerData.PostgresFlexCustomEndpoint != "" {
+		apiClient, err = postgresflex.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
+			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
+		)
+	} else {
+		apiClient, err = postgresflex.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
+			config.WithRegion(providerData.Region),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Postgresflex backups client configured")
+	d.client = apiClient
+}
+
+// Schema defines the schema for the data source.
+func (d *backupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "PostgresFlex backups data source schema. Lists the backups of a PostgresFlex instance, which are taken according to the instance's `backup_schedule`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`,`instance_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the instance is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "ID of the PostgresFlex instance whose backups are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"backups": schema.ListNestedAttribute{
+				Description: "The backups of the instance, sorted by start time, the latest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"backup_id": schema.StringAttribute{
+							Description: "ID of the backup.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the backup.",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Time at which the backup was started.",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "Time at which the backup finished.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "Size of the backup in bytes.",
+							Computed:    true,
+						},
+						"error": schema.StringAttribute{
+							Description: "Error message, if the backup failed.",
+							Computed:    true,
+						},
+						"labels": schema.ListAttribute{
+							Description: "Labels of the backup.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *backupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state Model
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	state.InstanceId = core.CanonicalizeIDValue(state.InstanceId)
+	projectId := state.ProjectId.ValueString()
+	instanceId := state.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	backupsResp, err := d.client.GetBackups(ctx, projectId, instanceId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to list backups", err.Error())
+		return
+	}
+
+	err = mapFields(backupsResp, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Postgresflex backups read")
+}
+
+func mapFields(backupsResp *postgresflex.BackupsResponse, model *Model) error {
+	if backupsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = types.StringValue(core.JoinID(model.ProjectId.ValueString(), model.InstanceId.ValueString()))
+	backups := []Backup{}
+	if backupsResp.Items != nil {
+		for _, b := range *backupsResp.Items {
+			if b.Id == nil {
+				return fmt.Errorf("backup id not present")
+			}
+			labels := types.ListNull(types.StringType)
+			if b.Labels != nil {
+				labelValues := []attr.Value{}
+				for _, label := range *b.Labels {
+					labelValues = append(labelValues, types.StringValue(label))
+				}
+				labelsList, diags := types.ListValue(types.StringType, labelValues)
+				if diags.HasError() {
+					return fmt.Errorf("mapping labels of backup %s: %w", *b.Id, core.DiagsToError(diags))
+				}
+				labels = labelsList
+			}
+			backups = append(backups, Backup{
+				BackupId:  types.StringPointerValue(b.Id),
+				Name:      types.StringPointerValue(b.Name),
+				StartTime: types.StringPointerValue(b.StartTime),
+				EndTime:   types.StringPointerValue(b.EndTime),
+				Size:      conversion.ToTypeInt64(b.Size),
+				Error:     types.StringPointerValue(b.Error),
+				Labels:    labels,
+			})
+		}
+	}
+	// The start times are in RFC 3339 format, which sorts lexicographically
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].StartTime.ValueString() > backups[j].StartTime.ValueString()
+	})
+	model.Backups = backups
+	return nil
+}
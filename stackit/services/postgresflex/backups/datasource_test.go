@@ -0,0 +1,121 @@
+package postgresflex
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *postgresflex.BackupsResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&postgresflex.BackupsResponse{},
+			Model{
+				Id:         types.StringValue("pid,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Backups:    []Backup{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&postgresflex.BackupsResponse{
+				Count: utils.Ptr(int32(2)),
+				Items: &[]postgresflex.InstanceBackup{
+					{
+						Id:        utils.Ptr("bid-1"),
+						Name:      utils.Ptr("backup-1"),
+						StartTime: utils.Ptr("2023-09-01T02:00:00Z"),
+						EndTime:   utils.Ptr("2023-09-01T02:05:00Z"),
+						Size:      utils.Ptr(int32(1024)),
+						Labels:    &[]string{"label"},
+					},
+					{
+						Id:        utils.Ptr("bid-2"),
+						Name:      utils.Ptr("backup-2"),
+						StartTime: utils.Ptr("2023-09-02T02:00:00Z"),
+						Error:     utils.Ptr("error"),
+					},
+				},
+			},
+			Model{
+				Id:         types.StringValue("pid,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Backups: []Backup{
+					{
+						BackupId:  types.StringValue("bid-2"),
+						Name:      types.StringValue("backup-2"),
+						StartTime: types.StringValue("2023-09-02T02:00:00Z"),
+						EndTime:   types.StringNull(),
+						Size:      types.Int64Null(),
+						Error:     types.StringValue("error"),
+						Labels:    types.ListNull(types.StringType),
+					},
+					{
+						BackupId:  types.StringValue("bid-1"),
+						Name:      types.StringValue("backup-1"),
+						StartTime: types.StringValue("2023-09-01T02:00:00Z"),
+						EndTime:   types.StringValue("2023-09-01T02:05:00Z"),
+						Size:      types.Int64Value(1024),
+						Error:     types.StringNull(),
+						Labels: types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("label"),
+						}),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"no_backup_id",
+			&postgresflex.BackupsResponse{
+				Items: &[]postgresflex.InstanceBackup{
+					{
+						Name: utils.Ptr("backup"),
+					},
+				},
+			},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId:  tt.expected.ProjectId,
+				InstanceId: tt.expected.InstanceId,
+			}
+			err := mapFields(tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
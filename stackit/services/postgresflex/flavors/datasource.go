@@ -0,0 +1,225 @@
+package postgresflex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// serviceEnablementHint describes how PostgresFlex is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate PostgresFlex for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &flavorsDataSource{}
+)
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ProjectId types.String `tfsdk:"project_id"`
+	CPU       types.Int64  `tfsdk:"cpu"`
+	RAM       types.Int64  `tfsdk:"ram"`
+	Flavors   []Flavor     `tfsdk:"flavors"`
+}
+
+type Flavor struct {
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	CPU         types.Int64  `tfsdk:"cpu"`
+	RAM         types.Int64  `tfsdk:"ram"`
+}
+
+// NewFlavorsDataSource is a helper function to simplify the provider implementation.
+func NewFlavorsDataSource() datasource.DataSource {
+	return &flavorsDataSource{}
+}
+
+// flavorsDataSource is the data source implementation.
+type flavorsDataSource struct {
+	client *postgresflex.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *flavorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresflex_flavors"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *flavorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *postgresflex.APIClient
+	var err error
+	if providerData.PostgresFlexCustomEndpoint != "" {
+		apiClient, err = postgresflex.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
+			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
+		)
+	} else {
+		apiClient, err = postgresflex.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
+			config.WithRegion(providerData.Region),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Postgresflex flavors client configured")
+	d.client = apiClient
+}
+
+// Schema defines the schema for the data source.
+func (d *flavorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "PostgresFlex flavors data source schema. Lists the flavors available for PostgresFlex instances in the region of the provider, " +
+			"e.g. to look up the `cpu` and `ram` combinations that can be used in the `flavor` of `stackit_postgresflex_instance`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the flavors are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"cpu": schema.Int64Attribute{
+				Description: "If set, only the flavors with this number of CPUs are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ram": schema.Int64Attribute{
+				Description: "If set, only the flavors with this amount of memory in GB are returned.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"flavors": schema.ListNestedAttribute{
+				Description: "The available flavors, sorted by CPU and memory.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the flavor.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the flavor.",
+							Computed:    true,
+						},
+						"cpu": schema.Int64Attribute{
+							Description: "Number of CPUs.",
+							Computed:    true,
+						},
+						"ram": schema.Int64Attribute{
+							Description: "Amount of memory in GB.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *flavorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state Model
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	projectId := state.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	flavorsResp, err := d.client.GetFlavors(ctx, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to list flavors", core.ServiceEnablementError(err, "PostgresFlex", projectId, serviceEnablementHint).Error())
+		return
+	}
+
+	err = mapFields(flavorsResp, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Postgresflex flavors read")
+}
+
+func mapFields(flavorsResp *postgresflex.FlavorsResponse, model *Model) error {
+	if flavorsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	flavors := []Flavor{}
+	if flavorsResp.Flavors != nil {
+		for _, f := range *flavorsResp.Flavors {
+			if f.Id == nil {
+				return fmt.Errorf("flavor id not present")
+			}
+			flavor := Flavor{
+				Id:          types.StringPointerValue(f.Id),
+				Description: types.StringPointerValue(f.Description),
+				CPU:         conversion.ToTypeInt64(f.Cpu),
+				RAM:         conversion.ToTypeInt64(f.Memory),
+			}
+			if !model.CPU.IsNull() && !flavor.CPU.Equal(model.CPU) {
+				continue
+			}
+			if !model.RAM.IsNull() && !flavor.RAM.Equal(model.RAM) {
+				continue
+			}
+			flavors = append(flavors, flavor)
+		}
+	}
+	sort.SliceStable(flavors, func(i, j int) bool {
+		if flavors[i].CPU.ValueInt64() != flavors[j].CPU.ValueInt64() {
+			return flavors[i].CPU.ValueInt64() < flavors[j].CPU.ValueInt64()
+		}
+		return flavors[i].RAM.ValueInt64() < flavors[j].RAM.ValueInt64()
+	})
+	model.Flavors = flavors
+	return nil
+}
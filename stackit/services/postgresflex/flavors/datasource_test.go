@@ -0,0 +1,154 @@
+package postgresflex
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+)
+
+func TestMapFields(t *testing.T) {
+	flavorsResp := &postgresflex.FlavorsResponse{
+		Flavors: &[]postgresflex.InstanceFlavor{
+			{
+				Id:          utils.Ptr("fid-4-8"),
+				Description: utils.Ptr("4 CPU, 8 GB"),
+				Cpu:         utils.Ptr(int32(4)),
+				Memory:      utils.Ptr(int32(8)),
+			},
+			{
+				Id:          utils.Ptr("fid-2-4"),
+				Description: utils.Ptr("2 CPU, 4 GB"),
+				Cpu:         utils.Ptr(int32(2)),
+				Memory:      utils.Ptr(int32(4)),
+			},
+			{
+				Id:          utils.Ptr("fid-4-16"),
+				Description: utils.Ptr("4 CPU, 16 GB"),
+				Cpu:         utils.Ptr(int32(4)),
+				Memory:      utils.Ptr(int32(16)),
+			},
+		},
+	}
+	flavor2x4 := Flavor{
+		Id:          types.StringValue("fid-2-4"),
+		Description: types.StringValue("2 CPU, 4 GB"),
+		CPU:         types.Int64Value(2),
+		RAM:         types.Int64Value(4),
+	}
+	flavor4x8 := Flavor{
+		Id:          types.StringValue("fid-4-8"),
+		Description: types.StringValue("4 CPU, 8 GB"),
+		CPU:         types.Int64Value(4),
+		RAM:         types.Int64Value(8),
+	}
+	flavor4x16 := Flavor{
+		Id:          types.StringValue("fid-4-16"),
+		Description: types.StringValue("4 CPU, 16 GB"),
+		CPU:         types.Int64Value(4),
+		RAM:         types.Int64Value(16),
+	}
+
+	tests := []struct {
+		description string
+		input       *postgresflex.FlavorsResponse
+		cpu         types.Int64
+		ram         types.Int64
+		expected    []Flavor
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&postgresflex.FlavorsResponse{},
+			types.Int64Null(),
+			types.Int64Null(),
+			[]Flavor{},
+			true,
+		},
+		{
+			"all_flavors_sorted",
+			flavorsResp,
+			types.Int64Null(),
+			types.Int64Null(),
+			[]Flavor{flavor2x4, flavor4x8, flavor4x16},
+			true,
+		},
+		{
+			"cpu_filter",
+			flavorsResp,
+			types.Int64Value(4),
+			types.Int64Null(),
+			[]Flavor{flavor4x8, flavor4x16},
+			true,
+		},
+		{
+			"cpu_and_ram_filter",
+			flavorsResp,
+			types.Int64Value(4),
+			types.Int64Value(16),
+			[]Flavor{flavor4x16},
+			true,
+		},
+		{
+			"no_match",
+			flavorsResp,
+			types.Int64Value(64),
+			types.Int64Null(),
+			[]Flavor{},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			types.Int64Null(),
+			types.Int64Null(),
+			nil,
+			false,
+		},
+		{
+			"no_flavor_id",
+			&postgresflex.FlavorsResponse{
+				Flavors: &[]postgresflex.InstanceFlavor{
+					{
+						Cpu: utils.Ptr(int32(2)),
+					},
+				},
+			},
+			types.Int64Null(),
+			types.Int64Null(),
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId: types.StringValue("pid"),
+				CPU:       tt.cpu,
+				RAM:       tt.ram,
+			}
+			err := mapFields(tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				expected := &Model{
+					Id:        types.StringValue("pid"),
+					ProjectId: types.StringValue("pid"),
+					CPU:       tt.cpu,
+					RAM:       tt.ram,
+					Flavors:   tt.expected,
+				}
+				diff := cmp.Diff(state, expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
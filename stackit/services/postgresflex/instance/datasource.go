@@ -54,12 +54,12 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	var err error
 	if providerData.PostgresFlexCustomEndpoint != "" {
 		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
 			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
 		)
 	} else {
 		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -82,6 +82,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		"project_id":  "STACKIT project ID to which the instance is associated.",
 		"name":        "Instance name.",
 		"acl":         "The Access Control List (ACL) for the PostgresFlex instance.",
+		"backup_schedule": "The schedule of the instance's backups in crontab syntax. E.g. `0 2 * * *` for a daily backup at 2am. " +
+			"The backups can be listed with the `stackit_postgresflex_backups` data source.",
+		"replicas": "Number of nodes of the instance. With more than one node, the instance runs as a cluster with one primary and read replicas, which take over if the primary fails. " +
+			"The number of replicas can be changed in place, without replacing the instance.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -95,7 +99,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["instance_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -103,7 +107,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["project_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -117,7 +121,8 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:    true,
 			},
 			"backup_schedule": schema.StringAttribute{
-				Computed: true,
+				Description: descriptions["backup_schedule"],
+				Computed:    true,
 			},
 			"flavor": schema.SingleNestedAttribute{
 				Computed: true,
@@ -137,7 +142,8 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				},
 			},
 			"replicas": schema.Int64Attribute{
-				Computed: true,
+				Description: descriptions["replicas"],
+				Computed:    true,
 			},
 			"storage": schema.SingleNestedAttribute{
 				Computed: true,
@@ -165,6 +171,9 @@ func (r *instanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.InstanceId = core.CanonicalizeIDValue(state.InstanceId)
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
 
 	projectId := state.ProjectId.ValueString()
 	instanceId := state.InstanceId.ValueString()
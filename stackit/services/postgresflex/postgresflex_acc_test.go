@@ -3,7 +3,6 @@ package postgresflex_test
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -138,8 +137,25 @@ func TestAccPostgresFlexFlexResource(t *testing.T) {
 						instance_id    = stackit_postgresflex_instance.instance.instance_id
 						user_id        = stackit_postgresflex_user.user.user_id
 					}
+
+					data "stackit_postgresflex_backups" "backups" {
+						project_id     = stackit_postgresflex_instance.instance.project_id
+						instance_id    = stackit_postgresflex_instance.instance.instance_id
+					}
+
+					data "stackit_postgresflex_flavors" "flavors" {
+						project_id     = stackit_postgresflex_instance.instance.project_id
+						cpu            = %s
+						ram            = %s
+					}
+
+					data "stackit_postgresflex_versions" "versions" {
+						project_id     = stackit_postgresflex_instance.instance.project_id
+					}
 					`,
 					configResources(),
+					instanceResource["flavor_cpu"],
+					instanceResource["flavor_ram"],
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Instance data
@@ -175,6 +191,24 @@ func TestAccPostgresFlexFlexResource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.stackit_postgresflex_user.user", "roles.0", userResource["role"]),
 					resource.TestCheckResourceAttrSet("data.stackit_postgresflex_user.user", "host"),
 					resource.TestCheckResourceAttrSet("data.stackit_postgresflex_user.user", "port"),
+
+					// Backups data
+					resource.TestCheckResourceAttrPair(
+						"data.stackit_postgresflex_backups.backups", "instance_id",
+						"stackit_postgresflex_instance.instance", "instance_id",
+					),
+					resource.TestCheckResourceAttrSet("data.stackit_postgresflex_backups.backups", "backups.#"),
+
+					// Flavors data
+					resource.TestCheckResourceAttr("data.stackit_postgresflex_flavors.flavors", "flavors.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.stackit_postgresflex_flavors.flavors", "flavors.0.id",
+						"stackit_postgresflex_instance.instance", "flavor.id",
+					),
+
+					// Versions data
+					resource.TestCheckTypeSetElemAttr("data.stackit_postgresflex_versions.versions", "versions.*", instanceResource["version"]),
+					resource.TestCheckResourceAttrSet("data.stackit_postgresflex_versions.versions", "latest"),
 				),
 			},
 			// Import
@@ -295,7 +329,7 @@ func testAccCheckPostgresFlexDestroy(s *terraform.State) error {
 			continue
 		}
 		// instance terraform ID: = "[project_id],[instance_id]"
-		instanceId := strings.Split(rs.Primary.ID, core.Separator)[1]
+		instanceId := core.SplitID(rs.Primary.ID)[1]
 		instancesToDestroy = append(instancesToDestroy, instanceId)
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -21,6 +22,19 @@ var (
 	_ datasource.DataSource = &userDataSource{}
 )
 
+// DataSourceModel is the data source model. It has no rotate_when_changed, which only triggers the reset of the password in the resource.
+type DataSourceModel struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	UserId     types.String `tfsdk:"user_id"`
+	InstanceId types.String `tfsdk:"instance_id"`
+	ProjectId  types.String `tfsdk:"project_id"`
+	Username   types.String `tfsdk:"username"`
+	Roles      types.Set    `tfsdk:"roles"`
+	Password   types.String `tfsdk:"password"`
+	Host       types.String `tfsdk:"host"`
+	Port       types.Int64  `tfsdk:"port"`
+}
+
 // NewUserDataSource is a helper function to simplify the provider implementation.
 func NewUserDataSource() datasource.DataSource {
 	return &userDataSource{}
@@ -53,12 +67,12 @@ func (r *userDataSource) Configure(ctx context.Context, req datasource.Configure
 	var err error
 	if providerData.PostgresFlexCustomEndpoint != "" {
 		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
 			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
 		)
 	} else {
 		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -100,7 +114,7 @@ func (r *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: descriptions["instance_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -108,7 +122,7 @@ func (r *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: descriptions["project_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -119,10 +133,7 @@ func (r *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				ElementType: types.StringType,
 				Computed:    true,
 			},
-			"password": schema.StringAttribute{
-				Computed:  true,
-				Sensitive: true,
-			},
+			"password": schemas.DataSourceSensitiveComputedString(""),
 			"host": schema.StringAttribute{
 				Computed: true,
 			},
@@ -135,15 +146,18 @@ func (r *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
-	diags := req.Config.Get(ctx, &model)
+	var state DataSourceModel
+	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	projectId := model.ProjectId.ValueString()
-	instanceId := model.InstanceId.ValueString()
-	userId := model.UserId.ValueString()
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.InstanceId = core.CanonicalizeIDValue(state.InstanceId)
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	projectId := state.ProjectId.ValueString()
+	instanceId := state.InstanceId.ValueString()
+	userId := state.UserId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 	ctx = tflog.SetField(ctx, "user_id", userId)
@@ -155,14 +169,34 @@ func (r *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	// Map response body to schema and populate Computed attribute values
+	model := Model{
+		ProjectId:  state.ProjectId,
+		InstanceId: state.InstanceId,
+		UserId:     state.UserId,
+	}
 	err = mapFields(recordSetResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	state = toDataSourceModel(&model)
 
 	// Set refreshed state
-	diags = resp.State.Set(ctx, model)
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	tflog.Info(ctx, "Postgresql user read")
 }
+
+func toDataSourceModel(model *Model) DataSourceModel {
+	return DataSourceModel{
+		Id:         model.Id,
+		UserId:     model.UserId,
+		InstanceId: model.InstanceId,
+		ProjectId:  model.ProjectId,
+		Username:   model.Username,
+		Roles:      model.Roles,
+		Password:   model.Password,
+		Host:       model.Host,
+		Port:       model.Port,
+	}
+}
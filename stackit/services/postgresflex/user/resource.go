@@ -3,7 +3,6 @@ package postgresflex
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -12,12 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -43,6 +44,8 @@ type Model struct {
 	Password   types.String `tfsdk:"password"`
 	Host       types.String `tfsdk:"host"`
 	Port       types.Int64  `tfsdk:"port"`
+	// RotateWhenChanged only controls the resource's behavior, it isn't returned by the API
+	RotateWhenChanged types.Map `tfsdk:"rotate_when_changed"`
 }
 
 // NewUserResource is a helper function to simplify the provider implementation.
@@ -52,7 +55,8 @@ func NewUserResource() resource.Resource {
 
 // userResource is the resource implementation.
 type userResource struct {
-	client *postgresflex.APIClient
+	client          *postgresflex.APIClient
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -77,12 +81,12 @@ func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	var err error
 	if providerData.PostgresFlexCustomEndpoint != "" {
 		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
 			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
 		)
 	} else {
 		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -94,16 +98,20 @@ func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 	tflog.Info(ctx, "Postgresflex user client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
 }
 
 // Schema defines the schema for the resource.
 func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
-		"main":        "PostgresFlex user resource schema.",
+		"main": "PostgresFlex user resource schema. " +
+			"The password is generated by the API. To reset it, change any value of `rotate_when_changed`, which recreates the user with a new password.",
 		"id":          "Terraform's internal resource ID.",
 		"user_id":     "User ID.",
 		"instance_id": "ID of the PostgresFlex instance.",
 		"project_id":  "STACKIT project ID to which the instance is associated.",
+		"rotate_when_changed": "A map of arbitrary key/value pairs that will force a reset of the user's password when changed, " +
+			"e.g. a timestamp to reset it on a schedule. The user is deleted and created again with a new password.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -168,16 +176,21 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					),
 				},
 			},
-			"password": schema.StringAttribute{
-				Computed:  true,
-				Sensitive: true,
-			},
+			"password": schemas.SensitiveComputedString(""),
 			"host": schema.StringAttribute{
 				Computed: true,
 			},
 			"port": schema.Int64Attribute{
 				Computed: true,
 			},
+			"rotate_when_changed": schema.MapAttribute{
+				Description: descriptions["rotate_when_changed"],
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -297,9 +310,9 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting user", err.Error())
 	}
-	_, err = postgresflex.DeleteUserWaitHandler(ctx, r.client, projectId, instanceId, userId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	_, err = postgresflex.DeleteUserWaitHandler(ctx, r.client, projectId, instanceId, userId).SetTimeout(r.defaultTimeouts.DeleteOr(1 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting user", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting user", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "Postgresflex user deleted")
@@ -308,7 +321,7 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,zone_id,record_set_id
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Unexpected Import Identifier",
@@ -342,7 +355,7 @@ func mapFieldsCreate(userResp *postgresflex.CreateUserResponse, model *Model) er
 		userId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.UserId = types.StringValue(userId)
 	model.Username = types.StringPointerValue(user.Username)
@@ -393,7 +406,7 @@ func mapFields(userResp *postgresflex.UserResponse, model *Model) error {
 		userId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.UserId = types.StringValue(userId)
 	model.Username = types.StringPointerValue(user.Username)
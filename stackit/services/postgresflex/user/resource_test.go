@@ -25,16 +25,42 @@ func TestMapFieldsCreate(t *testing.T) {
 					Password: utils.Ptr(""),
 				},
 			},
+			Model{
+				Id:                types.StringValue("pid,iid,uid"),
+				UserId:            types.StringValue("uid"),
+				InstanceId:        types.StringValue("iid"),
+				ProjectId:         types.StringValue("pid"),
+				Username:          types.StringNull(),
+				Roles:             types.SetNull(types.StringType),
+				Password:          types.StringValue(""),
+				Host:              types.StringNull(),
+				Port:              types.Int64Null(),
+				RotateWhenChanged: types.MapNull(types.StringType),
+			},
+			true,
+		},
+		{
+			"rotate_when_changed_kept",
+			&postgresflex.CreateUserResponse{
+				Item: &postgresflex.InstanceUser{
+					Id:       utils.Ptr("uid"),
+					Roles:    &[]string{},
+					Password: utils.Ptr("password"),
+				},
+			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Username:   types.StringNull(),
-				Roles:      types.SetNull(types.StringType),
-				Password:   types.StringValue(""),
+				Roles:      types.SetValueMust(types.StringType, []attr.Value{}),
+				Password:   types.StringValue("password"),
 				Host:       types.StringNull(),
 				Port:       types.Int64Null(),
+				RotateWhenChanged: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"rotation": types.StringValue("2023-09-01"),
+				}),
 			},
 			true,
 		},
@@ -65,9 +91,10 @@ func TestMapFieldsCreate(t *testing.T) {
 					types.StringValue("role_2"),
 					types.StringValue(""),
 				}),
-				Password: types.StringValue("password"),
-				Host:     types.StringValue("host"),
-				Port:     types.Int64Value(1234),
+				Password:          types.StringValue("password"),
+				Host:              types.StringValue("host"),
+				Port:              types.Int64Value(1234),
+				RotateWhenChanged: types.MapNull(types.StringType),
 			},
 			true,
 		},
@@ -84,15 +111,16 @@ func TestMapFieldsCreate(t *testing.T) {
 				},
 			},
 			Model{
-				Id:         types.StringValue("pid,iid,uid"),
-				UserId:     types.StringValue("uid"),
-				InstanceId: types.StringValue("iid"),
-				ProjectId:  types.StringValue("pid"),
-				Username:   types.StringNull(),
-				Roles:      types.SetValueMust(types.StringType, []attr.Value{}),
-				Password:   types.StringValue(""),
-				Host:       types.StringNull(),
-				Port:       types.Int64Value(2123456789),
+				Id:                types.StringValue("pid,iid,uid"),
+				UserId:            types.StringValue("uid"),
+				InstanceId:        types.StringValue("iid"),
+				ProjectId:         types.StringValue("pid"),
+				Username:          types.StringNull(),
+				Roles:             types.SetValueMust(types.StringType, []attr.Value{}),
+				Password:          types.StringValue(""),
+				Host:              types.StringNull(),
+				Port:              types.Int64Value(2123456789),
+				RotateWhenChanged: types.MapNull(types.StringType),
 			},
 			true,
 		},
@@ -130,8 +158,9 @@ func TestMapFieldsCreate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
 			state := &Model{
-				ProjectId:  tt.expected.ProjectId,
-				InstanceId: tt.expected.InstanceId,
+				ProjectId:         tt.expected.ProjectId,
+				InstanceId:        tt.expected.InstanceId,
+				RotateWhenChanged: tt.expected.RotateWhenChanged,
 			}
 			err := mapFieldsCreate(tt.input, state)
 			if !tt.isValid && err == nil {
@@ -163,14 +192,15 @@ func TestMapCreate(t *testing.T) {
 				Item: &postgresflex.UserResponseUser{},
 			},
 			Model{
-				Id:         types.StringValue("pid,iid,uid"),
-				UserId:     types.StringValue("uid"),
-				InstanceId: types.StringValue("iid"),
-				ProjectId:  types.StringValue("pid"),
-				Username:   types.StringNull(),
-				Roles:      types.SetNull(types.StringType),
-				Host:       types.StringNull(),
-				Port:       types.Int64Null(),
+				Id:                types.StringValue("pid,iid,uid"),
+				UserId:            types.StringValue("uid"),
+				InstanceId:        types.StringValue("iid"),
+				ProjectId:         types.StringValue("pid"),
+				Username:          types.StringNull(),
+				Roles:             types.SetNull(types.StringType),
+				Host:              types.StringNull(),
+				Port:              types.Int64Null(),
+				RotateWhenChanged: types.MapNull(types.StringType),
 			},
 			true,
 		},
@@ -199,8 +229,9 @@ func TestMapCreate(t *testing.T) {
 					types.StringValue("role_2"),
 					types.StringValue(""),
 				}),
-				Host: types.StringValue("host"),
-				Port: types.Int64Value(1234),
+				Host:              types.StringValue("host"),
+				Port:              types.Int64Value(1234),
+				RotateWhenChanged: types.MapNull(types.StringType),
 			},
 			true,
 		},
@@ -216,14 +247,15 @@ func TestMapCreate(t *testing.T) {
 				},
 			},
 			Model{
-				Id:         types.StringValue("pid,iid,uid"),
-				UserId:     types.StringValue("uid"),
-				InstanceId: types.StringValue("iid"),
-				ProjectId:  types.StringValue("pid"),
-				Username:   types.StringNull(),
-				Roles:      types.SetValueMust(types.StringType, []attr.Value{}),
-				Host:       types.StringNull(),
-				Port:       types.Int64Value(2123456789),
+				Id:                types.StringValue("pid,iid,uid"),
+				UserId:            types.StringValue("uid"),
+				InstanceId:        types.StringValue("iid"),
+				ProjectId:         types.StringValue("pid"),
+				Username:          types.StringNull(),
+				Roles:             types.SetValueMust(types.StringType, []attr.Value{}),
+				Host:              types.StringNull(),
+				Port:              types.Int64Value(2123456789),
+				RotateWhenChanged: types.MapNull(types.StringType),
 			},
 			true,
 		},
@@ -251,9 +283,10 @@ func TestMapCreate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
 			state := &Model{
-				ProjectId:  tt.expected.ProjectId,
-				InstanceId: tt.expected.InstanceId,
-				UserId:     tt.expected.UserId,
+				ProjectId:         tt.expected.ProjectId,
+				InstanceId:        tt.expected.InstanceId,
+				UserId:            tt.expected.UserId,
+				RotateWhenChanged: tt.expected.RotateWhenChanged,
 			}
 			err := mapFields(tt.input, state)
 			if !tt.isValid && err == nil {
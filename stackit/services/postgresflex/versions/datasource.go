@@ -0,0 +1,204 @@
+package postgresflex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// serviceEnablementHint describes how PostgresFlex is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate PostgresFlex for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &versionsDataSource{}
+)
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ProjectId types.String `tfsdk:"project_id"`
+	Versions  types.List   `tfsdk:"versions"`
+	Latest    types.String `tfsdk:"latest"`
+}
+
+// NewVersionsDataSource is a helper function to simplify the provider implementation.
+func NewVersionsDataSource() datasource.DataSource {
+	return &versionsDataSource{}
+}
+
+// versionsDataSource is the data source implementation.
+type versionsDataSource struct {
+	client *postgresflex.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *versionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresflex_versions"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *versionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *postgresflex.APIClient
+	var err error
+	if providerData.PostgresFlexCustomEndpoint != "" {
+		apiClient, err = postgresflex.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
+			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
+		)
+	} else {
+		apiClient, err = postgresflex.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresflex")),
+			config.WithRegion(providerData.Region),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Postgresflex versions client configured")
+	d.client = apiClient
+}
+
+// Schema defines the schema for the data source.
+func (d *versionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "PostgresFlex versions data source schema. Lists the PostgreSQL versions supported for PostgresFlex instances in the region of the provider, " +
+			"e.g. to use the latest version for the `version` of `stackit_postgresflex_instance` or to check in a precondition that a pinned version is still supported.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the versions are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"versions": schema.ListAttribute{
+				Description: "The supported PostgreSQL versions, sorted from the oldest to the latest. E.g. `[\"12\", \"13\", \"14\"]`",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"latest": schema.StringAttribute{
+				Description: "The latest supported PostgreSQL version. Not set if no version is supported.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *versionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state Model
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	projectId := state.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	versionsResp, err := d.client.GetVersions(ctx, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to list versions", core.ServiceEnablementError(err, "PostgresFlex", projectId, serviceEnablementHint).Error())
+		return
+	}
+
+	err = mapFields(versionsResp, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Postgresflex versions read")
+}
+
+func mapFields(versionsResp *postgresflex.VersionsResponse, model *Model) error {
+	if versionsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	versions := []string{}
+	if versionsResp.Versions != nil {
+		versions = append(versions, *versionsResp.Versions...)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return compareVersions(versions[i], versions[j]) < 0
+	})
+
+	versionValues := []attr.Value{}
+	for _, v := range versions {
+		versionValues = append(versionValues, types.StringValue(v))
+	}
+	versionsList, diags := types.ListValue(types.StringType, versionValues)
+	if diags.HasError() {
+		return fmt.Errorf("mapping versions: %w", core.DiagsToError(diags))
+	}
+
+	model.Id = model.ProjectId
+	model.Versions = versionsList
+	model.Latest = types.StringNull()
+	if len(versions) > 0 {
+		model.Latest = types.StringValue(versions[len(versions)-1])
+	}
+	return nil
+}
+
+// compareVersions compares two versions like "14" or "9.6" numerically, part by part.
+// Parts that aren't numbers are compared as strings.
+func compareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNumber, aErr := strconv.Atoi(aParts[i])
+		bNumber, bErr := strconv.Atoi(bParts[i])
+		switch {
+		case aErr == nil && bErr == nil && aNumber != bNumber:
+			if aNumber < bNumber {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && aParts[i] != bParts[i]:
+			return strings.Compare(aParts[i], bParts[i])
+		}
+	}
+	return len(aParts) - len(bParts)
+}
@@ -0,0 +1,99 @@
+package postgresflex
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *postgresflex.VersionsResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&postgresflex.VersionsResponse{},
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Versions:  types.ListValueMust(types.StringType, []attr.Value{}),
+				Latest:    types.StringNull(),
+			},
+			true,
+		},
+		{
+			"sorted_numerically",
+			&postgresflex.VersionsResponse{
+				Versions: &[]string{"14", "9.6", "12", "13"},
+			},
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Versions: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("9.6"),
+					types.StringValue("12"),
+					types.StringValue("13"),
+					types.StringValue("14"),
+				}),
+				Latest: types.StringValue("14"),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapFields(tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"12", "12", 0},
+		{"9", "12", -1},
+		{"14", "13", 1},
+		{"9.6", "12", -1},
+		{"12.1", "12", 1},
+		{"12", "12.1", -1},
+		{"12.beta", "12.rc", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			got := compareVersions(tt.a, tt.b)
+			if (got < 0) != (tt.expected < 0) || (got > 0) != (tt.expected > 0) {
+				t.Fatalf("compareVersions(%q, %q): expected sign of %d, got %d", tt.a, tt.b, tt.expected, got)
+			}
+		})
+	}
+}
@@ -3,19 +3,20 @@ package postgresql
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = &credentialsResource{}
 	_ resource.ResourceWithConfigure   = &credentialsResource{}
 	_ resource.ResourceWithImportState = &credentialsResource{}
+	_ resource.ResourceWithModifyPlan  = &credentialsResource{}
 )
 
 type Model struct {
@@ -43,6 +45,10 @@ type Model struct {
 	Port          types.Int64  `tfsdk:"port"`
 	Uri           types.String `tfsdk:"uri"`
 	Username      types.String `tfsdk:"username"`
+
+	RotateWhenChanged types.Map    `tfsdk:"rotate_when_changed"`
+	RotateAfter       types.String `tfsdk:"rotate_after"`
+	CreatedAt         types.String `tfsdk:"created_at"`
 }
 
 // NewCredentialsResource is a helper function to simplify the provider implementation.
@@ -52,7 +58,8 @@ func NewCredentialsResource() resource.Resource {
 
 // credentialsResource is the resource implementation.
 type credentialsResource struct {
-	client *postgresql.APIClient
+	client          *postgresql.APIClient
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -77,12 +84,12 @@ func (r *credentialsResource) Configure(ctx context.Context, req resource.Config
 	var err error
 	if providerData.PostgreSQLCustomEndpoint != "" {
 		apiClient, err = postgresql.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresql")),
 			config.WithEndpoint(providerData.PostgreSQLCustomEndpoint),
 		)
 	} else {
 		apiClient, err = postgresql.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresql")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -94,16 +101,20 @@ func (r *credentialsResource) Configure(ctx context.Context, req resource.Config
 
 	tflog.Info(ctx, "Postgresql zone client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
 }
 
 // Schema defines the schema for the resource.
 func (r *credentialsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
-		"main":           "PostgreSQL credentials resource schema.",
+		"main": "PostgreSQL credentials resource schema. " +
+			"Changing any value of `rotate_when_changed` creates new credentials and deletes the previous ones.",
 		"id":             "Terraform's internal resource identifier.",
 		"credentials_id": "The credentials ID.",
 		"instance_id":    "ID of the PostgreSQL instance.",
 		"project_id":     "STACKIT Project ID to which the instance is associated.",
+		"rotate_when_changed": "A map of arbitrary key/value pairs that will force a rotation of the credentials when changed, " +
+			"e.g. a timestamp to rotate them on a schedule. New credentials are created and the old ones are deleted.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -164,23 +175,33 @@ func (r *credentialsResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"name": schema.StringAttribute{
 				Computed: true,
 			},
-			"password": schema.StringAttribute{
-				Computed:  true,
-				Sensitive: true,
-			},
+			"password": schemas.SensitiveComputedString(""),
 			"port": schema.Int64Attribute{
 				Computed: true,
 			},
-			"uri": schema.StringAttribute{
-				Computed: true,
-			},
+			"uri": schemas.SensitiveComputedString(""),
 			"username": schema.StringAttribute{
 				Computed: true,
 			},
+			"rotate_after": schemas.RotateAfter(),
+			"created_at":   schemas.CreatedAt(),
+			"rotate_when_changed": schema.MapAttribute{
+				Description: descriptions["rotate_when_changed"],
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
 
+// ModifyPlan rotates the credentials if they are older than rotate_after.
+func (r *credentialsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	core.RotateCredentials(ctx, req, resp, time.Now())
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *credentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
@@ -207,9 +228,9 @@ func (r *credentialsResource) Create(ctx context.Context, req resource.CreateReq
 	credentialsId := *credentialsResp.Id
 	ctx = tflog.SetField(ctx, "credentials_id", credentialsId)
 
-	wr, err := postgresql.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	wr, err := postgresql.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(r.defaultTimeouts.CreateOr(1 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credentials", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credentials", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*postgresql.CredentialsResponse)
@@ -224,6 +245,7 @@ func (r *credentialsResource) Create(ctx context.Context, req resource.CreateReq
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	model.CreatedAt = core.CreatedAt(time.Now())
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	tflog.Info(ctx, "Postgresql credentials created")
@@ -257,6 +279,11 @@ func (r *credentialsResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// Credentials that were imported or created by earlier provider versions have no creation time yet
+	if model.CreatedAt.IsNull() {
+		model.CreatedAt = core.CreatedAt(time.Now())
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -264,9 +291,25 @@ func (r *credentialsResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (r *credentialsResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// Update shouldn't be called
-	resp.Diagnostics.AddError("Error updating credentials", "credentials can't be updated")
+func (r *credentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Only rotate_after can be updated, all other attributes require the replacement of the credentials.
+	// The credentials are kept as they are in the state, as they are unknown in the plan
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var plan Model
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.RotateAfter = plan.RotateAfter
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "PostgreSQL credentials updated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -290,9 +333,9 @@ func (r *credentialsResource) Delete(ctx context.Context, req resource.DeleteReq
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credentials", err.Error())
 	}
-	_, err = postgresql.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(1 * time.Minute).WaitWithContext(ctx)
+	_, err = postgresql.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialsId).SetTimeout(r.defaultTimeouts.DeleteOr(1 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credentials", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credentials", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "Postgresql credentials deleted")
@@ -301,7 +344,7 @@ func (r *credentialsResource) Delete(ctx context.Context, req resource.DeleteReq
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id,credentials_id
 func (r *credentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Unexpected Import Identifier",
@@ -343,7 +386,7 @@ func mapFields(credentialsResp *postgresql.CredentialsResponse, model *Model) er
 		credentialsId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.CredentialsId = types.StringValue(credentialsId)
 	model.Hosts = types.ListNull(types.StringType)
@@ -53,12 +53,12 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	var err error
 	if providerData.PostgreSQLCustomEndpoint != "" {
 		apiClient, err = postgresql.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresql")),
 			config.WithEndpoint(providerData.PostgreSQLCustomEndpoint),
 		)
 	} else {
 		apiClient, err = postgresql.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresql")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -96,7 +96,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["instance_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -104,7 +104,7 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["project_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -175,6 +175,9 @@ func (r *instanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.InstanceId = core.CanonicalizeIDValue(state.InstanceId)
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
 
 	projectId := state.ProjectId.ValueString()
 	instanceId := state.InstanceId.ValueString()
@@ -19,17 +19,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/argus"
 	"github.com/stackitcloud/stackit-sdk-go/services/postgresql"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 )
 
+// serviceEnablementHint describes how PostgreSQL is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate PostgreSQL for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &instanceResource{}
-	_ resource.ResourceWithConfigure   = &instanceResource{}
-	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.Resource                   = &instanceResource{}
+	_ resource.ResourceWithConfigure      = &instanceResource{}
+	_ resource.ResourceWithImportState    = &instanceResource{}
+	_ resource.ResourceWithModifyPlan     = &instanceResource{}
+	_ resource.ResourceWithValidateConfig = &instanceResource{}
 )
 
 type Model struct {
@@ -48,6 +54,9 @@ type Model struct {
 	PlanId             types.String `tfsdk:"plan_id"`
 }
 
+// replacementComputedAttributes are the computed attributes whose values change if the instance is replaced.
+var replacementComputedAttributes = []string{"instance_id", "cf_guid", "cf_space_guid", "cf_organization_guid", "dashboard_url"}
+
 // Struct corresponding to DataSourceModel.Parameters
 type parametersModel struct {
 	EnableMonitoring     types.Bool   `tfsdk:"enable_monitoring"`
@@ -76,6 +85,12 @@ func NewInstanceResource() resource.Resource {
 // instanceResource is the resource implementation.
 type instanceResource struct {
 	client *postgresql.APIClient
+	// argusClient is used to validate the monitoring instance
+	argusClient     *argus.APIClient
+	strict          bool
+	defaultTimeouts core.Timeouts
+	// region is used in the errors about offerings, which depend on the region
+	region string
 }
 
 // Metadata returns the resource type name.
@@ -100,12 +115,30 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	var err error
 	if providerData.PostgreSQLCustomEndpoint != "" {
 		apiClient, err = postgresql.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresql")),
 			config.WithEndpoint(providerData.PostgreSQLCustomEndpoint),
 		)
 	} else {
 		apiClient, err = postgresql.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresql")),
+			config.WithRegion(providerData.Region),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	var argusClient *argus.APIClient
+	if providerData.ArgusCustomEndpoint != "" {
+		argusClient, err = argus.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
+			config.WithEndpoint(providerData.ArgusCustomEndpoint),
+		)
+	} else {
+		argusClient, err = argus.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("argus")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -117,6 +150,10 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 
 	tflog.Info(ctx, "Postgresql zone client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
+	r.strict = providerData.Strict
+	r.argusClient = argusClient
+	r.region = providerData.Region
 }
 
 // Schema defines the schema for the resource.
@@ -191,7 +228,8 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"parameters": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"enable_monitoring": schema.BoolAttribute{
-						Optional: true,
+						Description: "Enables the monitoring of the instance by the Argus instance set in `monitoring_instance_id`.",
+						Optional:    true,
 					},
 					"metrics_frequency": schema.Int64Attribute{
 						Optional: true,
@@ -200,15 +238,23 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						Optional: true,
 					},
 					"monitoring_instance_id": schema.StringAttribute{
-						Optional: true,
+						Description: "ID of the Argus instance, in the same project, which monitors the instance. Required if `enable_monitoring` is true.",
+						Optional:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+						},
 					},
 					"plugins": schema.ListAttribute{
 						ElementType: types.StringType,
 						Optional:    true,
 					},
 					"sgw_acl": schema.StringAttribute{
-						Optional: true,
-						Computed: true,
+						Description: "Comma separated list of IP networks in CIDR notation which are allowed to access this instance. E.g. `192.168.0.0/24,10.0.0.0/8`",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							validate.SgwACL(),
+						},
 					},
 				},
 				Optional: true,
@@ -248,6 +294,118 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+// ValidateConfig validates the resource configuration.
+func (r *instanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var parameters types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	if resp.Diagnostics.HasError() || parameters.IsNull() || parameters.IsUnknown() {
+		return
+	}
+	var parametersValues parametersModel
+	resp.Diagnostics.Append(parameters.As(ctx, &parametersValues, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkMonitoringConfig(&parametersValues)...)
+}
+
+// checkMonitoringConfig validates that a monitoring instance is set if monitoring is enabled.
+func checkMonitoringConfig(parameters *parametersModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !parameters.EnableMonitoring.ValueBool() {
+		return diags
+	}
+	if parameters.MonitoringInstanceId.IsNull() {
+		diags.AddAttributeError(path.Root("parameters").AtName("monitoring_instance_id"), "Missing monitoring instance",
+			"monitoring_instance_id must be set to the ID of an Argus instance if enable_monitoring is true")
+	}
+	return diags
+}
+
+// ModifyPlan warns if the instance is replaced, as resources referencing its computed attributes change as well.
+// It also validates that the offering is available in the region, to fail at plan time instead of on create,
+// and that the monitoring instance exists, as the service ignores monitoring instances it can't find.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	core.WarnOnReplacement(ctx, req, resp, r.strict, "PostgreSQL instance", []string{"project_id", "name"}, replacementComputedAttributes)
+
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var projectId types.String
+	var parameters types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	if resp.Diagnostics.HasError() || projectId.IsUnknown() {
+		return
+	}
+	r.checkOffering(ctx, req, resp, projectId.ValueString())
+	if resp.Diagnostics.HasError() || parameters.IsNull() || parameters.IsUnknown() {
+		return
+	}
+	var parametersValues parametersModel
+	resp.Diagnostics.Append(parameters.As(ctx, &parametersValues, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	monitoringInstanceId := parametersValues.MonitoringInstanceId
+	if !parametersValues.EnableMonitoring.ValueBool() || monitoringInstanceId.IsNull() || monitoringInstanceId.IsUnknown() {
+		return
+	}
+	if r.argusClient == nil {
+		return
+	}
+	ctx = tflog.SetField(ctx, "project_id", projectId.ValueString())
+	ctx = tflog.SetField(ctx, "monitoring_instance_id", monitoringInstanceId.ValueString())
+
+	_, err := r.argusClient.GetInstance(ctx, monitoringInstanceId.ValueString(), projectId.ValueString()).Execute()
+	resp.Diagnostics.Append(checkMonitoringInstance(ctx, err, projectId.ValueString(), monitoringInstanceId.ValueString())...)
+}
+
+// checkOffering validates that the planned version and plan name are offered in the region, if they are new or changed.
+// Errors listing the offerings skip the validation, they are reported when applying.
+func (r *instanceResource) checkOffering(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, projectId string) { // nolint:gocritic // passes the Terraform request along
+	var version, planName types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &version)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("plan_name"), &planName)...)
+	if resp.Diagnostics.HasError() || version.IsUnknown() || planName.IsUnknown() || r.client == nil {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var stateVersion, statePlanName types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("version"), &stateVersion)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("plan_name"), &statePlanName)...)
+		if resp.Diagnostics.HasError() || (version.Equal(stateVersion) && planName.Equal(statePlanName)) {
+			return
+		}
+	}
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	res, err := r.client.GetOfferings(ctx, projectId).Execute()
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping validation of the offering: %v", err))
+		return
+	}
+	_, diags := findPlanId(res, version.ValueString(), planName.ValueString(), r.region)
+	resp.Diagnostics.Append(diags...)
+}
+
+// checkMonitoringInstance interprets the error of getting the monitoring instance.
+// Only a missing instance is reported, other errors skip the validation so the plan doesn't depend on Argus being reachable.
+func checkMonitoringInstance(ctx context.Context, err error, projectId, monitoringInstanceId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err == nil {
+		return diags
+	}
+	if core.IsNotFound(err) {
+		diags.AddAttributeError(path.Root("parameters").AtName("monitoring_instance_id"), "Monitoring instance not found",
+			fmt.Sprintf("Argus instance %q doesn't exist in project %q. The monitoring instance has to be in the same project as the PostgreSQL instance.", monitoringInstanceId, projectId))
+		return diags
+	}
+	tflog.Warn(ctx, fmt.Sprintf("Skipping validation of the monitoring instance: %v", err))
+	return diags
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
@@ -298,14 +456,21 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new instance
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*payload).Execute()
 	if err != nil {
+		err = core.ServiceEnablementError(err, "PostgreSQL", projectId, serviceEnablementHint)
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
 	instanceId := *createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	wr, err := postgresql.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	wr, err := postgresql.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.CreateOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
+		// Track the instance, so it isn't left behind if the creation was interrupted or timed out
+		resp.Diagnostics.Append(core.SavePartialState(ctx, &resp.State, map[string]string{
+			"id":          core.JoinID(projectId, instanceId),
+			"project_id":  projectId,
+			"instance_id": instanceId,
+		})...)
 		return
 	}
 	got, ok := wr.(*postgresql.Instance)
@@ -376,6 +541,14 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	// The plan name and version aren't returned with the instance. If they are unknown, e.g. after an import,
+	// they are resolved from the plan ID, so that configurations generated by "terraform plan -generate-config-out" are valid
+	if state.PlanName.IsNull() || state.Version.IsNull() {
+		err = r.loadPlanNameAndVersion(ctx, &state)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Resolving plan name and version: %v", err))
+		}
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -433,9 +606,9 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", err.Error())
 		return
 	}
-	wr, err := postgresql.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	wr, err := postgresql.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.UpdateOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*postgresql.Instance)
@@ -498,9 +671,9 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", err.Error())
 		return
 	}
-	_, err = postgresql.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	_, err = postgresql.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.DeleteOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "Postgresql instance deleted")
@@ -509,7 +682,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -546,7 +719,7 @@ func mapFields(instance *postgresql.Instance, model *Model) error {
 		instanceId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.InstanceId = types.StringValue(instanceId)
 	model.PlanId = types.StringPointerValue(instance.PlanId)
@@ -672,33 +845,87 @@ func (r *instanceResource) loadPlanId(ctx context.Context, diags *diag.Diagnosti
 		return
 	}
 
-	version := model.Version.ValueString()
-	planName := model.PlanName.ValueString()
+	planId, planDiags := findPlanId(res, model.Version.ValueString(), model.PlanName.ValueString(), r.region)
+	diags.Append(planDiags...)
+	if planDiags.HasError() {
+		return
+	}
+	model.PlanId = types.StringValue(planId)
+}
+
+// findPlanId returns the ID of the plan with the given name and version in the offerings of the region.
+// If there is none, the returned diagnostics list the available versions or plan names.
+func findPlanId(offerings *postgresql.OfferingList, version, planName, region string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	inRegion := ""
+	if region != "" {
+		inRegion = fmt.Sprintf(" in region %s", region)
+	}
+	if offerings == nil || offerings.Offerings == nil {
+		diags.AddError("Invalid version", fmt.Sprintf("Couldn't find version '%s'%s, there are no offerings", version, inRegion))
+		return "", diags
+	}
+
 	availableVersions := ""
 	availablePlanNames := ""
 	isValidVersion := false
-	for _, offer := range *res.Offerings {
+	for _, offer := range *offerings.Offerings {
+		if offer.Version == nil {
+			continue
+		}
 		if !strings.EqualFold(*offer.Version, version) {
 			availableVersions = fmt.Sprintf("%s\n- %s", availableVersions, *offer.Version)
 			continue
 		}
 		isValidVersion = true
+		if offer.Plans == nil {
+			continue
+		}
 
 		for _, plan := range *offer.Plans {
 			if plan.Name == nil {
 				continue
 			}
 			if strings.EqualFold(*plan.Name, planName) && plan.Id != nil {
-				model.PlanId = types.StringPointerValue(plan.Id)
-				return
+				return *plan.Id, diags
 			}
 			availablePlanNames = fmt.Sprintf("%s\n- %s", availablePlanNames, *plan.Name)
 		}
 	}
 
 	if !isValidVersion {
-		diags.AddError("Invalid version", fmt.Sprintf("Couldn't find version '%s', available versions are:%s", version, availableVersions))
-		return
+		diags.AddAttributeError(path.Root("version"), "Invalid version", fmt.Sprintf("Couldn't find version '%s'%s, available versions are:%s", version, inRegion, availableVersions))
+		return "", diags
+	}
+	diags.AddAttributeError(path.Root("plan_name"), "Invalid plan_name", fmt.Sprintf("Couldn't find plan_name '%s' for version %s%s, available names are:%s", planName, version, inRegion, availablePlanNames))
+	return "", diags
+}
+
+// loadPlanNameAndVersion sets the plan name and version of the model from the offering matching its plan ID.
+func (r *instanceResource) loadPlanNameAndVersion(ctx context.Context, model *Model) error {
+	res, err := r.client.GetOfferings(ctx, model.ProjectId.ValueString()).Execute()
+	if err != nil {
+		return fmt.Errorf("listing offerings: %w", err)
+	}
+	return mapPlanNameAndVersion(res, model)
+}
+
+func mapPlanNameAndVersion(offerings *postgresql.OfferingList, model *Model) error {
+	if offerings == nil || offerings.Offerings == nil {
+		return fmt.Errorf("offerings input is nil")
+	}
+	planId := model.PlanId.ValueString()
+	for _, offer := range *offerings.Offerings {
+		if offer.Plans == nil {
+			continue
+		}
+		for _, plan := range *offer.Plans {
+			if plan.Id != nil && *plan.Id == planId {
+				model.PlanName = types.StringPointerValue(plan.Name)
+				model.Version = types.StringPointerValue(offer.Version)
+				return nil
+			}
+		}
 	}
-	diags.AddError("Invalid plan_name", fmt.Sprintf("Couldn't find plan_name '%s' for version %s, available names are:%s", planName, version, availablePlanNames))
+	return fmt.Errorf("plan %s not found in the offerings", planId)
 }
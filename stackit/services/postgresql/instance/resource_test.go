@@ -1,6 +1,9 @@
 package postgresql
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -10,6 +13,18 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/postgresql"
 )
 
+type apiError struct {
+	statusCode int
+}
+
+func (e apiError) Error() string {
+	return fmt.Sprintf("status code %d", e.statusCode)
+}
+
+func (e apiError) StatusCode() int {
+	return e.statusCode
+}
+
 func TestMapFields(t *testing.T) {
 	tests := []struct {
 		description string
@@ -433,3 +448,243 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckMonitoringConfig(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *parametersModel
+		isValid     bool
+	}{
+		{
+			"monitoring_disabled",
+			&parametersModel{EnableMonitoring: types.BoolNull(), MonitoringInstanceId: types.StringNull()},
+			true,
+		},
+		{
+			"monitoring_enabled",
+			&parametersModel{EnableMonitoring: types.BoolValue(true), MonitoringInstanceId: types.StringValue("mid")},
+			true,
+		},
+		{
+			"monitoring_instance_unknown",
+			&parametersModel{EnableMonitoring: types.BoolValue(true), MonitoringInstanceId: types.StringUnknown()},
+			true,
+		},
+		{
+			"monitoring_instance_missing",
+			&parametersModel{EnableMonitoring: types.BoolValue(true), MonitoringInstanceId: types.StringNull()},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkMonitoringConfig(tt.input)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestCheckMonitoringInstance(t *testing.T) {
+	tests := []struct {
+		description string
+		input       error
+		isValid     bool
+	}{
+		{
+			"instance_exists",
+			nil,
+			true,
+		},
+		{
+			"instance_not_found",
+			&apiError{statusCode: http.StatusNotFound},
+			false,
+		},
+		{
+			"other_error_skipped",
+			&apiError{statusCode: http.StatusInternalServerError},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkMonitoringInstance(context.Background(), tt.input, "pid", "mid")
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestMapPlanNameAndVersion(t *testing.T) {
+	offerings := &postgresql.OfferingList{
+		Offerings: &[]postgresql.Offering{
+			{
+				Version: utils.Ptr("1"),
+				Plans: &[]postgresql.Plan{
+					{Id: utils.Ptr("pid-1"), Name: utils.Ptr("plan-1")},
+				},
+			},
+			{
+				Version: utils.Ptr("2"),
+				Plans: &[]postgresql.Plan{
+					{Id: nil, Name: utils.Ptr("no-id")},
+					{Id: utils.Ptr("pid-2"), Name: utils.Ptr("plan-2")},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		description string
+		planId      string
+		input       *postgresql.OfferingList
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"ok",
+			"pid-2",
+			offerings,
+			Model{
+				PlanId:   types.StringValue("pid-2"),
+				PlanName: types.StringValue("plan-2"),
+				Version:  types.StringValue("2"),
+			},
+			true,
+		},
+		{
+			"plan_not_found",
+			"pid-3",
+			offerings,
+			Model{},
+			false,
+		},
+		{
+			"offerings_nil",
+			"pid-1",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				PlanId:   types.StringValue(tt.planId),
+				PlanName: types.StringNull(),
+				Version:  types.StringNull(),
+			}
+			err := mapPlanNameAndVersion(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model.PlanName, tt.expected.PlanName)
+				if diff != "" {
+					t.Fatalf("Plan name does not match: %s", diff)
+				}
+				diff = cmp.Diff(model.Version, tt.expected.Version)
+				if diff != "" {
+					t.Fatalf("Version does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFindPlanId(t *testing.T) {
+	offerings := &postgresql.OfferingList{
+		Offerings: &[]postgresql.Offering{
+			{
+				Version: utils.Ptr("1"),
+				Plans: &[]postgresql.Plan{
+					{Id: utils.Ptr("pid-1"), Name: utils.Ptr("plan-1")},
+				},
+			},
+			{
+				Version: utils.Ptr("2"),
+				Plans: &[]postgresql.Plan{
+					{Id: nil, Name: utils.Ptr("no-id")},
+					{Id: utils.Ptr("pid-2"), Name: utils.Ptr("plan-2")},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		description    string
+		input          *postgresql.OfferingList
+		version        string
+		planName       string
+		expectedPlanId string
+		expectedDetail string
+		isValid        bool
+	}{
+		{
+			"ok",
+			offerings,
+			"2",
+			"PLAN-2",
+			"pid-2",
+			"",
+			true,
+		},
+		{
+			"version_not_found",
+			offerings,
+			"3",
+			"plan-1",
+			"",
+			"Couldn't find version '3' in region eu01, available versions are:\n- 1\n- 2",
+			false,
+		},
+		{
+			"plan_not_found",
+			offerings,
+			"2",
+			"plan-1",
+			"",
+			"Couldn't find plan_name 'plan-1' for version 2 in region eu01, available names are:\n- no-id\n- plan-2",
+			false,
+		},
+		{
+			"offerings_nil",
+			nil,
+			"1",
+			"plan-1",
+			"",
+			"Couldn't find version '1' in region eu01, there are no offerings",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			planId, diags := findPlanId(tt.input, tt.version, tt.planName, "eu01")
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			if tt.isValid {
+				if planId != tt.expectedPlanId {
+					t.Fatalf("Plan ID does not match: expecting %s, got %s", tt.expectedPlanId, planId)
+				}
+				return
+			}
+			if detail := diags.Errors()[0].Detail(); detail != tt.expectedDetail {
+				t.Fatalf("Error detail does not match: expecting %q, got %q", tt.expectedDetail, detail)
+			}
+		})
+	}
+}
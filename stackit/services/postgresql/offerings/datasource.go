@@ -0,0 +1,250 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresql"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// serviceEnablementHint describes how PostgreSQL is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate PostgreSQL for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &offeringsDataSource{}
+)
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ProjectId types.String `tfsdk:"project_id"`
+	Version   types.String `tfsdk:"version"`
+	Offerings []Offering   `tfsdk:"offerings"`
+}
+
+type Offering struct {
+	Version     types.String `tfsdk:"version"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Latest      types.Bool   `tfsdk:"latest"`
+	Plans       []Plan       `tfsdk:"plans"`
+}
+
+type Plan struct {
+	PlanId      types.String `tfsdk:"plan_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Free        types.Bool   `tfsdk:"free"`
+}
+
+// NewOfferingsDataSource is a helper function to simplify the provider implementation.
+func NewOfferingsDataSource() datasource.DataSource {
+	return &offeringsDataSource{}
+}
+
+// offeringsDataSource is the data source implementation.
+type offeringsDataSource struct {
+	client *postgresql.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *offeringsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresql_offerings"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *offeringsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	var apiClient *postgresql.APIClient
+	var err error
+	if providerData.PostgreSQLCustomEndpoint != "" {
+		apiClient, err = postgresql.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresql")),
+			config.WithEndpoint(providerData.PostgreSQLCustomEndpoint),
+		)
+	} else {
+		apiClient, err = postgresql.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("postgresql")),
+			config.WithRegion(providerData.Region),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "PostgreSQL offerings client configured")
+	d.client = apiClient
+}
+
+// Schema defines the schema for the data source.
+func (d *offeringsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "PostgreSQL offerings data source schema. Lists the PostgreSQL versions and plans available in a project, e.g. to select the `version` and `plan_name` of a `stackit_postgresql_instance`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the offerings are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "If set, only the offering of this PostgreSQL version is returned. E.g. `13`",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"offerings": schema.ListNestedAttribute{
+				Description: "The PostgreSQL offerings, one per version.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Description: "The PostgreSQL version.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the offering.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the offering.",
+							Computed:    true,
+						},
+						"latest": schema.BoolAttribute{
+							Description: "Specifies if this is the latest version.",
+							Computed:    true,
+						},
+						"plans": schema.ListNestedAttribute{
+							Description: "The plans available for the version.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"plan_id": schema.StringAttribute{
+										Description: "The plan ID.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "The name of the plan, as used in `plan_name` of `stackit_postgresql_instance`.",
+										Computed:    true,
+									},
+									"description": schema.StringAttribute{
+										Description: "The description of the plan.",
+										Computed:    true,
+									},
+									"free": schema.BoolAttribute{
+										Description: "Specifies if the plan is free of charge.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *offeringsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state Model
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	projectId := state.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	offeringsResp, err := d.client.GetOfferings(ctx, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to list offerings", core.ServiceEnablementError(err, "PostgreSQL", projectId, serviceEnablementHint).Error())
+		return
+	}
+
+	err = mapFields(offeringsResp, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "PostgreSQL offerings read")
+}
+
+func mapFields(r *postgresql.OfferingList, model *Model) error {
+	if r == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	version := model.Version.ValueString()
+	offerings := []Offering{}
+	if r.Offerings != nil {
+		for _, o := range *r.Offerings {
+			if o.Version == nil {
+				return fmt.Errorf("offering version not present")
+			}
+			if version != "" && !strings.EqualFold(*o.Version, version) {
+				continue
+			}
+			plans := []Plan{}
+			if o.Plans != nil {
+				for _, p := range *o.Plans {
+					plans = append(plans, Plan{
+						PlanId:      types.StringPointerValue(p.Id),
+						Name:        types.StringPointerValue(p.Name),
+						Description: types.StringPointerValue(p.Description),
+						Free:        types.BoolPointerValue(p.Free),
+					})
+				}
+			}
+			offerings = append(offerings, Offering{
+				Version:     types.StringPointerValue(o.Version),
+				Name:        types.StringPointerValue(o.Name),
+				Description: types.StringPointerValue(o.Description),
+				Latest:      types.BoolPointerValue(o.Latest),
+				Plans:       plans,
+			})
+		}
+	}
+	model.Offerings = offerings
+	return nil
+}
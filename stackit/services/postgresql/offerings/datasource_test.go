@@ -0,0 +1,132 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresql"
+)
+
+func TestMapFields(t *testing.T) {
+	offerings := &postgresql.OfferingList{
+		Offerings: &[]postgresql.Offering{
+			{
+				Version:     utils.Ptr("12"),
+				Name:        utils.Ptr("postgresql"),
+				Description: utils.Ptr("PostgreSQL 12"),
+				Latest:      utils.Ptr(false),
+				Plans: &[]postgresql.Plan{
+					{Id: utils.Ptr("pid-1"), Name: utils.Ptr("plan-1"), Description: utils.Ptr("Plan 1"), Free: utils.Ptr(false)},
+				},
+			},
+			{
+				Version: utils.Ptr("13"),
+				Latest:  utils.Ptr(true),
+			},
+		},
+	}
+	tests := []struct {
+		description string
+		version     types.String
+		input       *postgresql.OfferingList
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			types.StringNull(),
+			&postgresql.OfferingList{},
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Version:   types.StringNull(),
+				Offerings: []Offering{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			types.StringNull(),
+			offerings,
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Version:   types.StringNull(),
+				Offerings: []Offering{
+					{
+						Version:     types.StringValue("12"),
+						Name:        types.StringValue("postgresql"),
+						Description: types.StringValue("PostgreSQL 12"),
+						Latest:      types.BoolValue(false),
+						Plans: []Plan{
+							{
+								PlanId:      types.StringValue("pid-1"),
+								Name:        types.StringValue("plan-1"),
+								Description: types.StringValue("Plan 1"),
+								Free:        types.BoolValue(false),
+							},
+						},
+					},
+					{
+						Version:     types.StringValue("13"),
+						Name:        types.StringNull(),
+						Description: types.StringNull(),
+						Latest:      types.BoolValue(true),
+						Plans:       []Plan{},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"version_filter",
+			types.StringValue("13"),
+			offerings,
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Version:   types.StringValue("13"),
+				Offerings: []Offering{
+					{
+						Version:     types.StringValue("13"),
+						Name:        types.StringNull(),
+						Description: types.StringNull(),
+						Latest:      types.BoolValue(true),
+						Plans:       []Plan{},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			types.StringNull(),
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId: types.StringValue("pid"),
+				Version:   tt.version,
+			}
+			err := mapFields(tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
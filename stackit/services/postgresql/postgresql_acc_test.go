@@ -100,6 +100,15 @@ func TestAccPostgreSQLResource(t *testing.T) {
 						project_id     = stackit_postgresql_credentials.credentials.project_id
 						instance_id    = stackit_postgresql_credentials.credentials.instance_id
 					    credentials_id = stackit_postgresql_credentials.credentials.credentials_id
+					}
+
+					data "stackit_postgresql_offerings" "offerings" {
+						project_id = stackit_postgresql_instance.instance.project_id
+					}
+
+					data "stackit_postgresql_instances" "instances" {
+						project_id = stackit_postgresql_instance.instance.project_id
+						name       = stackit_postgresql_instance.instance.name
 					}`,
 					resourceConfig(instanceResource["sgw_acl"], instanceResource["metrics_frequency"], instanceResource["plugins"]),
 				),
@@ -126,6 +135,17 @@ func TestAccPostgreSQLResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.stackit_postgresql_credentials.credentials", "host"),
 					resource.TestCheckResourceAttrSet("data.stackit_postgresql_credentials.credentials", "port"),
 					resource.TestCheckResourceAttrSet("data.stackit_postgresql_credentials.credentials", "uri"),
+
+					// Offerings data
+					resource.TestCheckResourceAttrSet("data.stackit_postgresql_offerings.offerings", "offerings.0.version"),
+					resource.TestCheckResourceAttrSet("data.stackit_postgresql_offerings.offerings", "offerings.0.plans.0.plan_id"),
+
+					// Instances data
+					resource.TestCheckResourceAttr("data.stackit_postgresql_instances.instances", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("stackit_postgresql_instance.instance", "instance_id",
+						"data.stackit_postgresql_instances.instances", "instances.0.instance_id"),
+					resource.TestCheckResourceAttr("data.stackit_postgresql_instances.instances", "instances.0.plan_id", instanceResource["plan_id"]),
+					resource.TestCheckResourceAttrSet("data.stackit_postgresql_instances.instances", "instances.0.plan_name"),
 				),
 			},
 			// Import
@@ -206,7 +226,7 @@ func testAccCheckPostgreSQLDestroy(s *terraform.State) error {
 			continue
 		}
 		// instance terraform ID: "[project_id],[instance_id]"
-		instanceId := strings.Split(rs.Primary.ID, core.Separator)[1]
+		instanceId := core.SplitID(rs.Primary.ID)[1]
 		instancesToDestroy = append(instancesToDestroy, instanceId)
 	}
 
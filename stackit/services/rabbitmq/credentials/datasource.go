@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -21,6 +22,22 @@ var (
 	_ datasource.DataSource = &credentialsDataSource{}
 )
 
+// DataSourceModel is the data source model, without the resource's rotate_after and the created_at timestamp it is computed from.
+type DataSourceModel struct {
+	Id            types.String `tfsdk:"id"` // needed by TF
+	CredentialsId types.String `tfsdk:"credentials_id"`
+	InstanceId    types.String `tfsdk:"instance_id"`
+	ProjectId     types.String `tfsdk:"project_id"`
+	Host          types.String `tfsdk:"host"`
+	Hosts         types.List   `tfsdk:"hosts"`
+	HttpAPIURI    types.String `tfsdk:"http_api_uri"`
+	Name          types.String `tfsdk:"name"`
+	Password      types.String `tfsdk:"password"`
+	Port          types.Int64  `tfsdk:"port"`
+	Uri           types.String `tfsdk:"uri"`
+	Username      types.String `tfsdk:"username"`
+}
+
 // NewCredentialsDataSource is a helper function to simplify the provider implementation.
 func NewCredentialsDataSource() datasource.DataSource {
 	return &credentialsDataSource{}
@@ -53,12 +70,12 @@ func (r *credentialsDataSource) Configure(ctx context.Context, req datasource.Co
 	var err error
 	if providerData.RabbitMQCustomEndpoint != "" {
 		apiClient, err = rabbitmq.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("rabbitmq")),
 			config.WithEndpoint(providerData.RabbitMQCustomEndpoint),
 		)
 	} else {
 		apiClient, err = rabbitmq.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("rabbitmq")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -93,7 +110,7 @@ func (r *credentialsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: descriptions["credentials_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -101,7 +118,7 @@ func (r *credentialsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: descriptions["instance_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -109,7 +126,7 @@ func (r *credentialsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: descriptions["project_id"],
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -126,16 +143,11 @@ func (r *credentialsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 			"name": schema.StringAttribute{
 				Computed: true,
 			},
-			"password": schema.StringAttribute{
-				Computed:  true,
-				Sensitive: true,
-			},
+			"password": schemas.DataSourceSensitiveComputedString(""),
 			"port": schema.Int64Attribute{
 				Computed: true,
 			},
-			"uri": schema.StringAttribute{
-				Computed: true,
-			},
+			"uri": schemas.DataSourceSensitiveComputedString(""),
 			"username": schema.StringAttribute{
 				Computed: true,
 			},
@@ -145,15 +157,19 @@ func (r *credentialsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 
 // Read refreshes the Terraform state with the latest data.
 func (r *credentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
-	diags := req.Config.Get(ctx, &model)
+	var state DataSourceModel
+	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	projectId := model.ProjectId.ValueString()
-	instanceId := model.InstanceId.ValueString()
-	credentialsId := model.CredentialsId.ValueString()
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.CredentialsId = core.CanonicalizeIDValue(state.CredentialsId)
+	state.InstanceId = core.CanonicalizeIDValue(state.InstanceId)
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	projectId := state.ProjectId.ValueString()
+	instanceId := state.InstanceId.ValueString()
+	credentialsId := state.CredentialsId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 	ctx = tflog.SetField(ctx, "credentials_id", credentialsId)
@@ -165,14 +181,37 @@ func (r *credentialsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Map response body to schema and populate Computed attribute values
+	model := Model{
+		ProjectId:     state.ProjectId,
+		InstanceId:    state.InstanceId,
+		CredentialsId: state.CredentialsId,
+	}
 	err = mapFields(recordSetResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	state = toDataSourceModel(&model)
 
 	// Set refreshed state
-	diags = resp.State.Set(ctx, model)
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	tflog.Info(ctx, "RabbitMQ credentials read")
 }
+
+func toDataSourceModel(model *Model) DataSourceModel {
+	return DataSourceModel{
+		Id:            model.Id,
+		CredentialsId: model.CredentialsId,
+		InstanceId:    model.InstanceId,
+		ProjectId:     model.ProjectId,
+		Host:          model.Host,
+		Hosts:         model.Hosts,
+		HttpAPIURI:    model.HttpAPIURI,
+		Name:          model.Name,
+		Password:      model.Password,
+		Port:          model.Port,
+		Uri:           model.Uri,
+		Username:      model.Username,
+	}
+}
@@ -6,9 +6,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -26,11 +28,15 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/rabbitmq"
 )
 
+// serviceEnablementHint describes how RabbitMQ is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate RabbitMQ for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 )
 
 type Model struct {
@@ -49,13 +55,18 @@ type Model struct {
 	PlanId             types.String `tfsdk:"plan_id"`
 }
 
+// replacementComputedAttributes are the computed attributes whose values change if the instance is replaced.
+var replacementComputedAttributes = []string{"instance_id", "cf_guid", "cf_space_guid", "cf_organization_guid", "dashboard_url"}
+
 // Struct corresponding to DataSourceModel.Parameters
 type parametersModel struct {
-	SgwAcl types.String `tfsdk:"sgw_acl"`
+	Plugins types.List   `tfsdk:"plugins"`
+	SgwAcl  types.String `tfsdk:"sgw_acl"`
 }
 
 // Types corresponding to parametersModel
 var parametersTypes = map[string]attr.Type{
+	"plugins": basetypes.ListType{ElemType: types.StringType},
 	"sgw_acl": basetypes.StringType{},
 }
 
@@ -66,7 +77,9 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *rabbitmq.APIClient
+	client          *rabbitmq.APIClient
+	strict          bool
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -91,12 +104,12 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	var err error
 	if providerData.RabbitMQCustomEndpoint != "" {
 		apiClient, err = rabbitmq.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("rabbitmq")),
 			config.WithEndpoint(providerData.RabbitMQCustomEndpoint),
 		)
 	} else {
 		apiClient, err = rabbitmq.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("rabbitmq")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -108,6 +121,8 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 
 	tflog.Info(ctx, "rabbitmq zone client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
+	r.strict = providerData.Strict
 }
 
 // Schema defines the schema for the resource.
@@ -181,11 +196,25 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"parameters": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
+					"plugins": schema.ListAttribute{
+						Description: "List of RabbitMQ plugins to enable on the instance, e.g. `rabbitmq_shovel`.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
 					"sgw_acl": schema.StringAttribute{
-						Optional: true,
-						Computed: true,
+						Description: "Comma separated list of IP networks in CIDR notation which are allowed to access this instance. E.g. `192.168.0.0/24,10.0.0.0/8`",
+						Optional:    true,
+						Computed:    true,
 						Validators: []validator.String{
 							stringvalidator.LengthAtLeast(1),
+							validate.SgwACL(),
 						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
@@ -217,6 +246,11 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+// ModifyPlan warns if the instance is replaced, as resources referencing its computed attributes change as well.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	core.WarnOnReplacement(ctx, req, resp, r.strict, "RabbitMQ instance", []string{"project_id", "name"}, replacementComputedAttributes)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
@@ -235,16 +269,30 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	var parameters = &parametersModel{}
+	var parametersPlugins *[]string
 	if !(model.Parameters.IsNull() || model.Parameters.IsUnknown()) {
 		diags = model.Parameters.As(ctx, parameters, basetypes.ObjectAsOptions{})
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		if !(parameters.Plugins.IsNull() || parameters.Plugins.IsUnknown()) {
+			var pp []types.String
+			res := []string{}
+			diags = parameters.Plugins.ElementsAs(ctx, &pp, false)
+			resp.Diagnostics.Append(diags...)
+			for _, v := range pp {
+				res = append(res, v.ValueString())
+			}
+			parametersPlugins = &res
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Generate API request body from model
-	payload, err := toCreatePayload(&model, parameters)
+	payload, err := toCreatePayload(&model, parameters, parametersPlugins)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -252,14 +300,21 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new instance
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*payload).Execute()
 	if err != nil {
+		err = core.ServiceEnablementError(err, "RabbitMQ", projectId, serviceEnablementHint)
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
 	instanceId := *createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	wr, err := rabbitmq.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	wr, err := rabbitmq.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.CreateOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
+		// Track the instance, so it isn't left behind if the creation was interrupted or timed out
+		resp.Diagnostics.Append(core.SavePartialState(ctx, &resp.State, map[string]string{
+			"id":          core.JoinID(projectId, instanceId),
+			"project_id":  projectId,
+			"instance_id": instanceId,
+		})...)
 		return
 	}
 	got, ok := wr.(*rabbitmq.Instance)
@@ -280,7 +335,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	tflog.Info(ctx, "rabbitmq instance created")
 }
 
-func toCreatePayload(model *Model, parameters *parametersModel) (*rabbitmq.CreateInstancePayload, error) {
+func toCreatePayload(model *Model, parameters *parametersModel, parametersPlugins *[]string) (*rabbitmq.CreateInstancePayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
 	}
@@ -290,7 +345,9 @@ func toCreatePayload(model *Model, parameters *parametersModel) (*rabbitmq.Creat
 			PlanId:       model.PlanId.ValueStringPointer(),
 		}, nil
 	}
-	payloadParams := &rabbitmq.InstanceParameters{}
+	payloadParams := &rabbitmq.InstanceParameters{
+		Plugins: parametersPlugins,
+	}
 	if parameters.SgwAcl.ValueString() != "" {
 		payloadParams.SgwAcl = parameters.SgwAcl.ValueStringPointer()
 	}
@@ -326,6 +383,14 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error mapping fields", err.Error())
 		return
 	}
+	// The plan name and version aren't returned with the instance. If they are unknown, e.g. after an import,
+	// they are resolved from the plan ID, so that configurations generated by "terraform plan -generate-config-out" are valid
+	if state.PlanName.IsNull() || state.Version.IsNull() {
+		err = r.loadPlanNameAndVersion(ctx, &state)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Resolving plan name and version: %v", err))
+		}
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -352,16 +417,30 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	var parameters = &parametersModel{}
+	var parametersPlugins *[]string
 	if !(model.Parameters.IsNull() || model.Parameters.IsUnknown()) {
 		diags = model.Parameters.As(ctx, parameters, basetypes.ObjectAsOptions{})
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		if !(parameters.Plugins.IsNull() || parameters.Plugins.IsUnknown()) {
+			var pp []types.String
+			res := []string{}
+			diags = parameters.Plugins.ElementsAs(ctx, &pp, false)
+			resp.Diagnostics.Append(diags...)
+			for _, v := range pp {
+				res = append(res, v.ValueString())
+			}
+			parametersPlugins = &res
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(&model, parameters)
+	payload, err := toUpdatePayload(&model, parameters, parametersPlugins)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Could not create API payload: %v", err))
 		return
@@ -372,9 +451,9 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", err.Error())
 		return
 	}
-	wr, err := rabbitmq.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	wr, err := rabbitmq.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.UpdateOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*rabbitmq.Instance)
@@ -394,7 +473,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Info(ctx, "rabbitmq instance updated")
 }
 
-func toUpdatePayload(model *Model, parameters *parametersModel) (*rabbitmq.UpdateInstancePayload, error) {
+func toUpdatePayload(model *Model, parameters *parametersModel, parametersPlugins *[]string) (*rabbitmq.UpdateInstancePayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
 	}
@@ -406,7 +485,8 @@ func toUpdatePayload(model *Model, parameters *parametersModel) (*rabbitmq.Updat
 	}
 	return &rabbitmq.UpdateInstancePayload{
 		Parameters: &rabbitmq.InstanceParameters{
-			SgwAcl: parameters.SgwAcl.ValueStringPointer(),
+			Plugins: parametersPlugins,
+			SgwAcl:  parameters.SgwAcl.ValueStringPointer(),
 		},
 		PlanId: model.PlanId.ValueStringPointer(),
 	}, nil
@@ -431,9 +511,9 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", err.Error())
 		return
 	}
-	_, err = rabbitmq.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	_, err = rabbitmq.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(r.defaultTimeouts.DeleteOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "rabbitmq instance deleted")
@@ -442,7 +522,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,instance_id
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
@@ -479,7 +559,7 @@ func mapFields(instance *rabbitmq.Instance, model *Model) error {
 		instanceId,
 	}
 	model.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 	model.InstanceId = types.StringValue(instanceId)
 	model.PlanId = types.StringPointerValue(instance.PlanId)
@@ -635,3 +715,32 @@ func (r *instanceResource) loadPlanId(ctx context.Context, diags *diag.Diagnosti
 	}
 	diags.AddError("Invalid plan_name", fmt.Sprintf("Couldn't find plan_name '%s' for version %s, available names are:%s", planName, version, availablePlanNames))
 }
+
+// loadPlanNameAndVersion sets the plan name and version of the model from the offering matching its plan ID.
+func (r *instanceResource) loadPlanNameAndVersion(ctx context.Context, model *Model) error {
+	res, err := r.client.GetOfferings(ctx, model.ProjectId.ValueString()).Execute()
+	if err != nil {
+		return fmt.Errorf("listing offerings: %w", err)
+	}
+	return mapPlanNameAndVersion(res, model)
+}
+
+func mapPlanNameAndVersion(offerings *rabbitmq.OfferingList, model *Model) error {
+	if offerings == nil || offerings.Offerings == nil {
+		return fmt.Errorf("offerings input is nil")
+	}
+	planId := model.PlanId.ValueString()
+	for _, offer := range *offerings.Offerings {
+		if offer.Plans == nil {
+			continue
+		}
+		for _, plan := range *offer.Plans {
+			if plan.Id != nil && *plan.Id == planId {
+				model.PlanName = types.StringPointerValue(plan.Name)
+				model.Version = types.StringPointerValue(offer.Version)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("plan %s not found in the offerings", planId)
+}
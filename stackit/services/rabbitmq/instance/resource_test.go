@@ -48,6 +48,7 @@ func TestMapFields(t *testing.T) {
 				CfOrganizationGuid: utils.Ptr("org"),
 				Parameters: &map[string]interface{}{
 					"sgw_acl": "acl",
+					"plugins": []interface{}{"rabbitmq_shovel", "rabbitmq_federation"},
 				},
 			},
 			Model{
@@ -63,6 +64,10 @@ func TestMapFields(t *testing.T) {
 				CfOrganizationGuid: types.StringValue("org"),
 				Parameters: types.ObjectValueMust(parametersTypes, map[string]attr.Value{
 					"sgw_acl": types.StringValue("acl"),
+					"plugins": types.ListValueMust(types.StringType, []attr.Value{
+						types.StringValue("rabbitmq_shovel"),
+						types.StringValue("rabbitmq_federation"),
+					}),
 				}),
 			},
 			true,
@@ -125,16 +130,18 @@ func TestMapFields(t *testing.T) {
 
 func TestToCreatePayload(t *testing.T) {
 	tests := []struct {
-		description     string
-		input           *Model
-		inputParameters *parametersModel
-		expected        *rabbitmq.CreateInstancePayload
-		isValid         bool
+		description            string
+		input                  *Model
+		inputParameters        *parametersModel
+		inputParametersPlugins *[]string
+		expected               *rabbitmq.CreateInstancePayload
+		isValid                bool
 	}{
 		{
 			"default_values",
 			&Model{},
 			&parametersModel{},
+			nil,
 			&rabbitmq.CreateInstancePayload{
 				Parameters: &rabbitmq.InstanceParameters{},
 			},
@@ -149,9 +156,15 @@ func TestToCreatePayload(t *testing.T) {
 			&parametersModel{
 				SgwAcl: types.StringValue("sgw"),
 			},
+			&[]string{
+				"rabbitmq_shovel",
+			},
 			&rabbitmq.CreateInstancePayload{
 				InstanceName: utils.Ptr("name"),
 				Parameters: &rabbitmq.InstanceParameters{
+					Plugins: &[]string{
+						"rabbitmq_shovel",
+					},
 					SgwAcl: utils.Ptr("sgw"),
 				},
 				PlanId: utils.Ptr("plan"),
@@ -167,6 +180,7 @@ func TestToCreatePayload(t *testing.T) {
 			&parametersModel{
 				SgwAcl: types.StringNull(),
 			},
+			nil,
 			&rabbitmq.CreateInstancePayload{
 				InstanceName: utils.Ptr(""),
 				Parameters: &rabbitmq.InstanceParameters{
@@ -181,6 +195,7 @@ func TestToCreatePayload(t *testing.T) {
 			nil,
 			&parametersModel{},
 			nil,
+			nil,
 			false,
 		},
 		{
@@ -190,6 +205,7 @@ func TestToCreatePayload(t *testing.T) {
 				PlanId: types.StringValue("plan"),
 			},
 			nil,
+			nil,
 			&rabbitmq.CreateInstancePayload{
 				InstanceName: utils.Ptr("name"),
 				PlanId:       utils.Ptr("plan"),
@@ -199,7 +215,7 @@ func TestToCreatePayload(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
-			output, err := toCreatePayload(tt.input, tt.inputParameters)
+			output, err := toCreatePayload(tt.input, tt.inputParameters, tt.inputParametersPlugins)
 			if !tt.isValid && err == nil {
 				t.Fatalf("Should have failed")
 			}
@@ -218,16 +234,18 @@ func TestToCreatePayload(t *testing.T) {
 
 func TestToUpdatePayload(t *testing.T) {
 	tests := []struct {
-		description     string
-		input           *Model
-		inputParameters *parametersModel
-		expected        *rabbitmq.UpdateInstancePayload
-		isValid         bool
+		description            string
+		input                  *Model
+		inputParameters        *parametersModel
+		inputParametersPlugins *[]string
+		expected               *rabbitmq.UpdateInstancePayload
+		isValid                bool
 	}{
 		{
 			"default_values",
 			&Model{},
 			&parametersModel{},
+			nil,
 			&rabbitmq.UpdateInstancePayload{
 				Parameters: &rabbitmq.InstanceParameters{},
 			},
@@ -241,8 +259,14 @@ func TestToUpdatePayload(t *testing.T) {
 			&parametersModel{
 				SgwAcl: types.StringValue("sgw"),
 			},
+			&[]string{
+				"rabbitmq_shovel",
+			},
 			&rabbitmq.UpdateInstancePayload{
 				Parameters: &rabbitmq.InstanceParameters{
+					Plugins: &[]string{
+						"rabbitmq_shovel",
+					},
 					SgwAcl: utils.Ptr("sgw"),
 				},
 				PlanId: utils.Ptr("plan"),
@@ -257,6 +281,7 @@ func TestToUpdatePayload(t *testing.T) {
 			&parametersModel{
 				SgwAcl: types.StringNull(),
 			},
+			nil,
 			&rabbitmq.UpdateInstancePayload{
 				Parameters: &rabbitmq.InstanceParameters{
 					SgwAcl: nil,
@@ -270,6 +295,7 @@ func TestToUpdatePayload(t *testing.T) {
 			nil,
 			&parametersModel{},
 			nil,
+			nil,
 			false,
 		},
 		{
@@ -278,6 +304,7 @@ func TestToUpdatePayload(t *testing.T) {
 				PlanId: types.StringValue("plan"),
 			},
 			nil,
+			nil,
 			&rabbitmq.UpdateInstancePayload{
 				PlanId: utils.Ptr("plan"),
 			},
@@ -286,7 +313,7 @@ func TestToUpdatePayload(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
-			output, err := toUpdatePayload(tt.input, tt.inputParameters)
+			output, err := toUpdatePayload(tt.input, tt.inputParameters, tt.inputParametersPlugins)
 			if !tt.isValid && err == nil {
 				t.Fatalf("Should have failed")
 			}
@@ -302,3 +329,82 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestMapPlanNameAndVersion(t *testing.T) {
+	offerings := &rabbitmq.OfferingList{
+		Offerings: &[]rabbitmq.Offering{
+			{
+				Version: utils.Ptr("1"),
+				Plans: &[]rabbitmq.Plan{
+					{Id: utils.Ptr("pid-1"), Name: utils.Ptr("plan-1")},
+				},
+			},
+			{
+				Version: utils.Ptr("2"),
+				Plans: &[]rabbitmq.Plan{
+					{Id: nil, Name: utils.Ptr("no-id")},
+					{Id: utils.Ptr("pid-2"), Name: utils.Ptr("plan-2")},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		description string
+		planId      string
+		input       *rabbitmq.OfferingList
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"ok",
+			"pid-2",
+			offerings,
+			Model{
+				PlanId:   types.StringValue("pid-2"),
+				PlanName: types.StringValue("plan-2"),
+				Version:  types.StringValue("2"),
+			},
+			true,
+		},
+		{
+			"plan_not_found",
+			"pid-3",
+			offerings,
+			Model{},
+			false,
+		},
+		{
+			"offerings_nil",
+			"pid-1",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				PlanId:   types.StringValue(tt.planId),
+				PlanName: types.StringNull(),
+				Version:  types.StringNull(),
+			}
+			err := mapPlanNameAndVersion(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model.PlanName, tt.expected.PlanName)
+				if diff != "" {
+					t.Fatalf("Plan name does not match: %s", diff)
+				}
+				diff = cmp.Diff(model.Version, tt.expected.Version)
+				if diff != "" {
+					t.Fatalf("Version does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package rabbitmq
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/rabbitmq"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
+)
+
+// serviceEnablementHint describes how RabbitMQ is enabled for a project, see core.ServiceEnablementError
+const serviceEnablementHint = "activate RabbitMQ for the project in the STACKIT Portal and make sure the service account has a role in the project"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &instancesDataSource{}
+)
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ProjectId types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	PlanName  types.String `tfsdk:"plan_name"`
+	Instances []Instance   `tfsdk:"instances"`
+}
+
+type Instance struct {
+	InstanceId         types.String `tfsdk:"instance_id"`
+	Name               types.String `tfsdk:"name"`
+	Version            types.String `tfsdk:"version"`
+	PlanName           types.String `tfsdk:"plan_name"`
+	PlanId             types.String `tfsdk:"plan_id"`
+	DashboardUrl       types.String `tfsdk:"dashboard_url"`
+	ImageUrl           types.String `tfsdk:"image_url"`
+	CfGuid             types.String `tfsdk:"cf_guid"`
+	CfSpaceGuid        types.String `tfsdk:"cf_space_guid"`
+	CfOrganizationGuid types.String `tfsdk:"cf_organization_guid"`
+}
+
+// plan holds the attributes of an offering plan that are not returned with the instances.
+type plan struct {
+	name    types.String
+	version types.String
+}
+
+// NewInstancesDataSource is a helper function to simplify the provider implementation.
+func NewInstancesDataSource() datasource.DataSource {
+	return &instancesDataSource{}
+}
+
+// instancesDataSource is the data source implementation.
+type instancesDataSource struct {
+	client *rabbitmq.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *instancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rabbitmq_instances"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *instancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected stackit.ProviderData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	var apiClient *rabbitmq.APIClient
+	var err error
+	if providerData.RabbitMQCustomEndpoint != "" {
+		apiClient, err = rabbitmq.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("rabbitmq")),
+			config.WithEndpoint(providerData.RabbitMQCustomEndpoint),
+		)
+	} else {
+		apiClient, err = rabbitmq.NewAPIClient(
+			config.WithCustomAuth(providerData.ServiceRoundTripper("rabbitmq")),
+			config.WithRegion(providerData.Region),
+		)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Could not Configure API Client", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "RabbitMQ instances client configured")
+	d.client = apiClient
+}
+
+// Schema defines the schema for the data source.
+func (d *instancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "RabbitMQ instances data source schema. Lists the RabbitMQ instances of a project, including the ones not managed by Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the instances are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.DataSourceUUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "If set, only the instances with this name are returned.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"plan_name": schema.StringAttribute{
+				Description: "If set, only the instances with this plan are returned.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"instances": schema.ListNestedAttribute{
+				Description: "The RabbitMQ instances.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Description: "ID of the RabbitMQ instance.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Instance name.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "The RabbitMQ version of the instance's plan. Not set if the plan is no longer offered.",
+							Computed:    true,
+						},
+						"plan_name": schema.StringAttribute{
+							Description: "The name of the instance's plan. Not set if the plan is no longer offered.",
+							Computed:    true,
+						},
+						"plan_id": schema.StringAttribute{
+							Description: "The ID of the instance's plan.",
+							Computed:    true,
+						},
+						"dashboard_url": schema.StringAttribute{
+							Computed: true,
+						},
+						"image_url": schema.StringAttribute{
+							Computed: true,
+						},
+						"cf_guid": schema.StringAttribute{
+							Computed: true,
+						},
+						"cf_space_guid": schema.StringAttribute{
+							Computed: true,
+						},
+						"cf_organization_guid": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *instancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var state Model
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
+	projectId := state.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	instancesResp, err := d.client.GetInstances(ctx, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to list instances", core.ServiceEnablementError(err, "RabbitMQ", projectId, serviceEnablementHint).Error())
+		return
+	}
+	// The instances only reference their plan by ID, the plan name and version are resolved from the offerings
+	offeringsResp, err := d.client.GetOfferings(ctx, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Unable to list offerings", core.ServiceEnablementError(err, "RabbitMQ", projectId, serviceEnablementHint).Error())
+		return
+	}
+
+	err = mapFields(instancesResp, offeringsResp, &state)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Mapping fields", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "RabbitMQ instances read")
+}
+
+func mapFields(instancesResp *rabbitmq.InstanceList, offeringsResp *rabbitmq.OfferingList, model *Model) error {
+	if instancesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	plans := mapPlans(offeringsResp)
+	name := model.Name.ValueString()
+	planName := model.PlanName.ValueString()
+
+	model.Id = model.ProjectId
+	instances := []Instance{}
+	if instancesResp.Instances != nil {
+		for _, i := range *instancesResp.Instances {
+			if i.InstanceId == nil {
+				return fmt.Errorf("instance id not present")
+			}
+			if name != "" && (i.Name == nil || *i.Name != name) {
+				continue
+			}
+			instance := Instance{
+				InstanceId:         types.StringPointerValue(i.InstanceId),
+				Name:               types.StringPointerValue(i.Name),
+				Version:            types.StringNull(),
+				PlanName:           types.StringNull(),
+				PlanId:             types.StringPointerValue(i.PlanId),
+				DashboardUrl:       types.StringPointerValue(i.DashboardUrl),
+				ImageUrl:           types.StringPointerValue(i.ImageUrl),
+				CfGuid:             types.StringPointerValue(i.CfGuid),
+				CfSpaceGuid:        types.StringPointerValue(i.CfSpaceGuid),
+				CfOrganizationGuid: types.StringPointerValue(i.CfOrganizationGuid),
+			}
+			if i.PlanId != nil {
+				if p, ok := plans[*i.PlanId]; ok {
+					instance.Version = p.version
+					instance.PlanName = p.name
+				}
+			}
+			if planName != "" && !strings.EqualFold(instance.PlanName.ValueString(), planName) {
+				continue
+			}
+			instances = append(instances, instance)
+		}
+	}
+	model.Instances = instances
+	return nil
+}
+
+// mapPlans maps the IDs of the offered plans to their name and version.
+func mapPlans(offeringsResp *rabbitmq.OfferingList) map[string]plan {
+	plans := map[string]plan{}
+	if offeringsResp == nil || offeringsResp.Offerings == nil {
+		return plans
+	}
+	for _, o := range *offeringsResp.Offerings {
+		if o.Plans == nil {
+			continue
+		}
+		for _, p := range *o.Plans {
+			if p.Id == nil {
+				continue
+			}
+			plans[*p.Id] = plan{
+				name:    types.StringPointerValue(p.Name),
+				version: types.StringPointerValue(o.Version),
+			}
+		}
+	}
+	return plans
+}
@@ -97,7 +97,7 @@ func TestAccRabbitMQResource(t *testing.T) {
 			// Creation fail
 			{
 				Config:      resourceConfig(&acls),
-				ExpectError: regexp.MustCompile(`.*sgw_acl is invalid.*`),
+				ExpectError: regexp.MustCompile(`.*not a valid list of CIDR networks.*`),
 			},
 			// Creation
 			{
@@ -137,6 +137,11 @@ func TestAccRabbitMQResource(t *testing.T) {
 						project_id     = stackit_rabbitmq_credentials.credentials.project_id
 						instance_id    = stackit_rabbitmq_credentials.credentials.instance_id
 					    credentials_id = stackit_rabbitmq_credentials.credentials.credentials_id
+					}
+
+					data "stackit_rabbitmq_instances" "instances" {
+						project_id = stackit_rabbitmq_instance.instance.project_id
+						name       = stackit_rabbitmq_instance.instance.name
 					}`,
 					resourceConfig(nil),
 				),
@@ -161,6 +166,13 @@ func TestAccRabbitMQResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.stackit_rabbitmq_credentials.credentials", "host"),
 					resource.TestCheckResourceAttrSet("data.stackit_rabbitmq_credentials.credentials", "port"),
 					resource.TestCheckResourceAttrSet("data.stackit_rabbitmq_credentials.credentials", "uri"),
+
+					// Instances data
+					resource.TestCheckResourceAttr("data.stackit_rabbitmq_instances.instances", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("stackit_rabbitmq_instance.instance", "instance_id",
+						"data.stackit_rabbitmq_instances.instances", "instances.0.instance_id"),
+					resource.TestCheckResourceAttr("data.stackit_rabbitmq_instances.instances", "instances.0.plan_id", instanceResource["plan_id"]),
+					resource.TestCheckResourceAttrSet("data.stackit_rabbitmq_instances.instances", "instances.0.plan_name"),
 				),
 			},
 			// Import
@@ -240,7 +252,7 @@ func testAccCheckRabbitMQDestroy(s *terraform.State) error {
 			continue
 		}
 		// instance terraform ID: "[project_id],[instance_id]"
-		instanceId := strings.Split(rs.Primary.ID, core.Separator)[1]
+		instanceId := core.SplitID(rs.Primary.ID)[1]
 		instancesToDestroy = append(instancesToDestroy, instanceId)
 	}
 
@@ -1,10 +1,13 @@
 package redis
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/redis"
@@ -154,3 +157,97 @@ func TestMapFields(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	model := func(rotateAfter types.String) Model {
+		return Model{
+			Id:            types.StringValue("pid,iid,cid"),
+			CredentialsId: types.StringValue("cid"),
+			InstanceId:    types.StringValue("iid"),
+			ProjectId:     types.StringValue("pid"),
+			Host:          types.StringValue("host"),
+			Hosts:         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("host")}),
+			HttpAPIURI:    types.StringValue("http_api_uri"),
+			Name:          types.StringValue("name"),
+			Password:      types.StringValue("password"),
+			Port:          types.Int64Value(1234),
+			Uri:           types.StringValue("uri"),
+			Username:      types.StringValue("username"),
+			RotateAfter:   rotateAfter,
+			CreatedAt:     types.StringValue("2023-09-01T12:00:00Z"),
+		}
+	}
+	// Changing rotate_after plans all computed attributes without plan modifier as unknown
+	plan := func(rotateAfter types.String) Model {
+		return Model{
+			Id:            types.StringValue("pid,iid,cid"),
+			CredentialsId: types.StringValue("cid"),
+			InstanceId:    types.StringValue("iid"),
+			ProjectId:     types.StringValue("pid"),
+			Host:          types.StringUnknown(),
+			Hosts:         types.ListUnknown(types.StringType),
+			HttpAPIURI:    types.StringUnknown(),
+			Name:          types.StringUnknown(),
+			Password:      types.StringUnknown(),
+			Port:          types.Int64Unknown(),
+			Uri:           types.StringUnknown(),
+			Username:      types.StringUnknown(),
+			RotateAfter:   rotateAfter,
+			CreatedAt:     types.StringValue("2023-09-01T12:00:00Z"),
+		}
+	}
+	tests := []struct {
+		description string
+		state       Model
+		plan        Model
+		expected    Model
+	}{
+		{
+			"rotate_after_changed",
+			model(types.StringValue("24h")),
+			plan(types.StringValue("48h")),
+			model(types.StringValue("48h")),
+		},
+		{
+			"rotate_after_removed",
+			model(types.StringValue("24h")),
+			plan(types.StringNull()),
+			model(types.StringNull()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := context.Background()
+			r := NewCredentialsResource()
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			req := resource.UpdateRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+			}
+			diags := req.State.Set(ctx, tt.state)
+			diags.Append(req.Plan.Set(ctx, tt.plan)...)
+			if diags.HasError() {
+				t.Fatalf("Setting up the request: %v", diags.Errors())
+			}
+			// The framework initializes the new state with the plan
+			resp := &resource.UpdateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: req.Plan.Raw},
+			}
+			r.Update(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			var output Model
+			diags = resp.State.Get(ctx, &output)
+			if diags.HasError() {
+				t.Fatalf("Reading the state: %v", diags.Errors())
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -66,13 +66,13 @@ func (d *projectDataSource) Configure(ctx context.Context, req datasource.Config
 
 	if providerData.ResourceManagerCustomEndpoint != "" {
 		apiClient, err = resourcemanager.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("resourcemanager")),
 			config.WithServiceAccountEmail(providerData.ServiceAccountEmail),
 			config.WithEndpoint(providerData.ResourceManagerCustomEndpoint),
 		)
 	} else {
 		apiClient, err = resourcemanager.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("resourcemanager")),
 			config.WithServiceAccountEmail(providerData.ServiceAccountEmail),
 			config.WithRegion(providerData.Region),
 		)
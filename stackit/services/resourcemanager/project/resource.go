@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
@@ -44,6 +43,13 @@ type Model struct {
 	Name              types.String `tfsdk:"name"`
 	Labels            types.Map    `tfsdk:"labels"`
 	OwnerEmail        types.String `tfsdk:"owner_email"`
+	Members           []Member     `tfsdk:"members"`
+}
+
+// Struct corresponding to Model.Members[i]
+type Member struct {
+	Subject types.String `tfsdk:"subject"`
+	Role    types.String `tfsdk:"role"`
 }
 
 // NewProjectResource is a helper function to simplify the provider implementation.
@@ -53,7 +59,8 @@ func NewProjectResource() resource.Resource {
 
 // projectResource is the resource implementation.
 type projectResource struct {
-	client *resourcemanager.APIClient
+	client          *resourcemanager.APIClient
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -79,13 +86,13 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 	if providerData.ResourceManagerCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "resourcemanager_custom_endpoint", providerData.ResourceManagerCustomEndpoint)
 		apiClient, err = resourcemanager.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("resourcemanager")),
 			config.WithServiceAccountEmail(providerData.ServiceAccountEmail),
 			config.WithEndpoint(providerData.ResourceManagerCustomEndpoint),
 		)
 	} else {
 		apiClient, err = resourcemanager.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("resourcemanager")),
 			config.WithServiceAccountEmail(providerData.ServiceAccountEmail),
 			config.WithRegion(providerData.Region),
 		)
@@ -98,6 +105,7 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 
 	tflog.Info(ctx, "Resource Manager project client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
 }
 
 // Schema defines the schema for the resource.
@@ -110,6 +118,9 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 		"name":                "Project name.",
 		"labels":              "Labels are key-value string pairs which can be attached to a resource container. A label key must match the regex [A-ZÄÜÖa-zäüöß0-9_-]{1,64}. A label value must match the regex ^$|[A-ZÄÜÖa-zäüöß0-9_-]{1,64}",
 		"owner_email":         "Email address of the owner of the project. This value is only considered during creation. Changing it afterwards will have no effect.",
+		"members":             "Additional members that are granted a role on the project when it is created, e.g. the team working on it, so they have access right away. This value is only considered during creation. Changing it afterwards will have no effect.",
+		"members.subject":     "Subject of the member, i.e. the email address of a user or service account.",
+		"members.role":        "Role of the member. E.g. `project.member`",
 	}
 
 	resp.Schema = schema.Schema{
@@ -168,6 +179,28 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: descriptions["owner_email"],
 				Required:    true,
 			},
+			"members": schema.ListNestedAttribute{
+				Description: descriptions["members"],
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subject": schema.StringAttribute{
+							Description: descriptions["members.subject"],
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"role": schema.StringAttribute{
+							Description: descriptions["members.role"],
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -210,9 +243,18 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// If the request has not been processed yet and the containerId doesnt exist,
 	// the waiter will fail with authentication error, so wait some time before checking the creation
-	wr, err := resourcemanager.CreateProjectWaitHandler(ctx, r.client, respContainerId).SetSleepBeforeWait(1 * time.Minute).SetTimeout(10 * time.Minute).WaitWithContext(ctx)
+	err = core.SleepWithContext(ctx, 1*time.Minute)
+	var wr interface{}
+	if err == nil {
+		wr, err = resourcemanager.CreateProjectWaitHandler(ctx, r.client, respContainerId).SetTimeout(r.defaultTimeouts.CreateOr(10 * time.Minute)).WaitWithContext(ctx)
+	}
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating project", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating project", fmt.Sprintf("Instance creation waiting: %v", core.WaitError(ctx, err)))
+		// Track the project, so it isn't left behind if the creation was interrupted or timed out
+		resp.Diagnostics.Append(core.SavePartialState(ctx, &resp.State, map[string]string{
+			"id":           respContainerId,
+			"container_id": respContainerId,
+		})...)
 		return
 	}
 	got, ok := wr.(*resourcemanager.ProjectResponseWithParents)
@@ -312,9 +354,9 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	_, err = resourcemanager.DeleteProjectWaitHandler(ctx, r.client, containerId).SetTimeout(10 * time.Minute).WaitWithContext(ctx)
+	_, err = resourcemanager.DeleteProjectWaitHandler(ctx, r.client, containerId).SetTimeout(r.defaultTimeouts.DeleteOr(10 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting project", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting project", fmt.Sprintf("Instance deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 
@@ -324,7 +366,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: container_id
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 	if len(idParts) != 1 || idParts[0] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
@@ -401,6 +443,19 @@ func toCreatePayload(model *Model, serviceAccountEmail string) (*resourcemanager
 			})
 	}
 
+	for _, m := range model.Members {
+		subject := m.Subject.ValueString()
+		role := m.Role.ValueString()
+		if hasMember(members, subject, role) {
+			continue
+		}
+		members = append(members,
+			resourcemanager.ProjectMember{
+				Subject: &subject,
+				Role:    &role,
+			})
+	}
+
 	modelLabels := model.Labels.Elements()
 	labels, err := conversion.ToOptStringMap(modelLabels)
 	if err != nil {
@@ -415,6 +470,16 @@ func toCreatePayload(model *Model, serviceAccountEmail string) (*resourcemanager
 	}, nil
 }
 
+// hasMember checks if the subject is already granted the role, e.g. because it's the owner of the project.
+func hasMember(members []resourcemanager.ProjectMember, subject, role string) bool {
+	for _, m := range members {
+		if m.Subject != nil && *m.Subject == subject && m.Role != nil && *m.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
 func toUpdatePayload(model *Model) (*resourcemanager.UpdateProjectPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
@@ -161,6 +161,42 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"members_ok",
+			&Model{
+				OwnerEmail: types.StringValue("owner_email"),
+				Members: []Member{
+					{Subject: types.StringValue("member_email"), Role: types.StringValue("project.member")},
+					{Subject: types.StringValue("owner_email"), Role: types.StringValue(projectOwner)},
+					{Subject: types.StringValue("owner_email"), Role: types.StringValue("project.auditor")},
+				},
+			},
+			nil,
+			&resourcemanager.CreateProjectPayload{
+				ContainerParentId: nil,
+				Labels:            nil,
+				Members: &[]resourcemanager.ProjectMember{
+					{
+						Role:    utils.Ptr(projectOwner),
+						Subject: utils.Ptr("service_account_email"),
+					},
+					{
+						Role:    utils.Ptr(projectOwner),
+						Subject: utils.Ptr("owner_email"),
+					},
+					{
+						Role:    utils.Ptr("project.member"),
+						Subject: utils.Ptr("member_email"),
+					},
+					{
+						Role:    utils.Ptr("project.auditor"),
+						Subject: utils.Ptr("owner_email"),
+					},
+				},
+				Name: nil,
+			},
+			true,
+		},
 		{
 			"nil_model",
 			nil,
@@ -13,6 +13,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/services/ske"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 )
 
@@ -53,12 +54,12 @@ func (r *clusterDataSource) Configure(ctx context.Context, req datasource.Config
 	var err error
 	if providerData.SKECustomEndpoint != "" {
 		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("ske")),
 			config.WithEndpoint(providerData.SKECustomEndpoint),
 		)
 	} else {
 		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("ske")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -83,7 +84,7 @@ func (r *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "STACKIT project ID to which the cluster is associated.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -268,11 +269,7 @@ func (r *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					},
 				},
 			},
-			"kube_config": schema.StringAttribute{
-				Description: "Kube config file used for connecting to the cluster",
-				Sensitive:   true,
-				Computed:    true,
-			},
+			"kube_config": schemas.DataSourceSensitiveComputedString("Kube config file used for connecting to the cluster"),
 		},
 	}
 }
@@ -285,6 +282,8 @@ func (r *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
 
 	projectId := state.ProjectId.ValueString()
 	name := state.Name.ValueString()
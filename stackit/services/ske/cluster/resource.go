@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/ske"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/schemas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/validate"
 	"golang.org/x/mod/semver"
 )
@@ -50,6 +52,7 @@ var (
 	_ resource.Resource                = &clusterResource{}
 	_ resource.ResourceWithConfigure   = &clusterResource{}
 	_ resource.ResourceWithImportState = &clusterResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterResource{}
 )
 
 type Cluster struct {
@@ -131,7 +134,11 @@ func NewClusterResource() resource.Resource {
 
 // clusterResource is the resource implementation.
 type clusterResource struct {
-	client *ske.APIClient
+	client          *ske.APIClient
+	strict          bool
+	defaultTimeouts core.Timeouts
+	// expirationWarningDays is the number of days before the expiration of the Kubernetes version from which plans warn about it
+	expirationWarningDays int64
 }
 
 // Metadata returns the resource type name.
@@ -156,12 +163,12 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	var err error
 	if providerData.SKECustomEndpoint != "" {
 		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("ske")),
 			config.WithEndpoint(providerData.SKECustomEndpoint),
 		)
 	} else {
 		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("ske")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -173,6 +180,9 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 
 	tflog.Info(ctx, "SKE cluster client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
+	r.strict = providerData.Strict
+	r.expirationWarningDays = providerData.SKEVersionExpirationWarningDays
 }
 
 // Schema defines the schema for the resource.
@@ -242,9 +252,14 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							Required:    true,
 						},
 						"availability_zones": schema.ListAttribute{
-							Description: "Specify a list of availability zones. E.g. `eu01-m`",
-							Required:    true,
+							Description: "Specify a list of availability zones. E.g. `eu01-m`. " +
+								"If omitted, the availability zones of the region are used in alphabetical order, at most as many as the maximum number of nodes in the pool.",
+							Optional:    true,
+							Computed:    true,
 							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
 						},
 						"minimum": schema.Int64Attribute{
 							Description: "Minimum number of nodes in the pool.",
@@ -441,14 +456,10 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					},
 				},
 			},
-			"kube_config": schema.StringAttribute{
-				Description: "Kube config file used for connecting to the cluster",
-				Sensitive:   true,
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
+			"kube_config": schemas.SensitiveComputedString(
+				"Kube config file used for connecting to the cluster",
+				stringplanmodifier.UseStateForUnknown(),
+			),
 		},
 	}
 }
@@ -466,6 +477,137 @@ func (r *clusterResource) ValidateConfig(ctx context.Context, req resource.Valid
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	for i := range model.NodePools {
+		diags = checkNodePoolSize(&model.NodePools[i], path.Root("node_pools").AtListIndex(i))
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// ModifyPlan keeps the default availability zones of the existing node pools and warns if the Kubernetes version of the cluster expires soon.
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// Nothing to plan if the cluster is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	planAvailabilityZones(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to check if the warning is disabled or the provider isn't configured yet
+	if r.expirationWarningDays == 0 || r.client == nil {
+		return
+	}
+	var kubernetesVersion types.String
+	diags := req.Plan.GetAttribute(ctx, path.Root("kubernetes_version"), &kubernetesVersion)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if kubernetesVersion.IsNull() || kubernetesVersion.IsUnknown() {
+		return
+	}
+
+	res, err := r.client.GetOptions(ctx).Execute()
+	if err != nil {
+		// The warning is best effort, the version is validated against the options again when applying
+		tflog.Warn(ctx, fmt.Sprintf("Unable to check the expiration of the kubernetes version: getting cluster options: %v", err))
+		return
+	}
+	if res.KubernetesVersions == nil {
+		return
+	}
+	warning, err := checkVersionExpiration(*res.KubernetesVersions, kubernetesVersion.ValueStringPointer(), r.expirationWarningDays, time.Now())
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to check the expiration of the kubernetes version: %v", err))
+		return
+	}
+	if warning != "" {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, r.strict, warning, "Upgrade the cluster to a newer kubernetes version before the expiration date.")
+	}
+}
+
+// planAvailabilityZones plans the availability zones of the node pools without configured zones.
+// They are taken from the node pool with the same name in the state, so that adding, removing or reordering
+// node pools doesn't move the zones of one pool to another. The zones of new node pools are computed when applying.
+func planAvailabilityZones(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var planNodePoolsList types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("node_pools"), &planNodePoolsList)...)
+	if resp.Diagnostics.HasError() || planNodePoolsList.IsNull() || planNodePoolsList.IsUnknown() {
+		return
+	}
+	var planNodePools, stateNodePools []NodePool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("node_pools"), &planNodePools)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("node_pools"), &stateNodePools)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, zones := range availabilityZonesFromState(planNodePools, stateNodePools) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("node_pools").AtListIndex(i).AtName("availability_zones"), zones)...)
+	}
+}
+
+// availabilityZonesFromState returns the availability zones of the planned node pools with unknown zones, by list index,
+// that can be taken from the node pool with the same name in the state.
+// Zones that exceed the planned maximum number of nodes are not kept, the defaults are computed again when applying.
+func availabilityZonesFromState(planNodePools, stateNodePools []NodePool) map[int]types.List {
+	stateZones := map[string]types.List{}
+	for _, nodePool := range stateNodePools {
+		if nodePool.Name.IsNull() || nodePool.AvailabilityZones.IsNull() || nodePool.AvailabilityZones.IsUnknown() {
+			continue
+		}
+		stateZones[nodePool.Name.ValueString()] = nodePool.AvailabilityZones
+	}
+
+	res := map[int]types.List{}
+	for i, nodePool := range planNodePools {
+		if !nodePool.AvailabilityZones.IsUnknown() || nodePool.Name.IsUnknown() {
+			continue
+		}
+		zones, ok := stateZones[nodePool.Name.ValueString()]
+		if !ok {
+			continue
+		}
+		if !nodePool.Maximum.IsUnknown() && nodePool.Maximum.ValueInt64() < int64(len(zones.Elements())) {
+			continue
+		}
+		res[i] = zones
+	}
+	return res
+}
+
+// checkVersionExpiration returns a warning message if the kubernetes version matching the provided one
+// expires within the given number of days after now, or already expired. Otherwise, the message is empty.
+func checkVersionExpiration(availableVersions []ske.KubernetesVersion, providedVersion *string, warningDays int64, now time.Time) (string, error) {
+	versionUsed, _, err := latestMatchingVersion(availableVersions, providedVersion)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range availableVersions {
+		if v.Version == nil || *v.Version != *versionUsed {
+			continue
+		}
+		if v.ExpirationDate == nil {
+			return "", nil
+		}
+		expiration, err := time.Parse(time.RFC3339, *v.ExpirationDate)
+		if err != nil {
+			return "", fmt.Errorf("parsing expiration date of version %s: %w", *versionUsed, err)
+		}
+		if expiration.Before(now) {
+			return fmt.Sprintf("Kubernetes version %s expired on %s", *versionUsed, expiration.Format(time.DateOnly)), nil
+		}
+		if expiration.Before(now.AddDate(0, 0, int(warningDays))) {
+			return fmt.Sprintf("Kubernetes version %s expires on %s", *versionUsed, expiration.Format(time.DateOnly)), nil
+		}
+		return "", nil
+	}
+	return "", nil
 }
 
 func checkAllowPrivilegedContainers(allowPrivilegeContainers types.Bool, kubernetesVersion types.String) diag.Diagnostics {
@@ -489,6 +631,72 @@ func checkAllowPrivilegedContainers(allowPrivilegeContainers types.Bool, kuberne
 	return diags
 }
 
+// checkNodePoolSize checks that the minimum and maximum number of nodes of the node pool are consistent
+// and that the pool can have at least one node in each of the configured availability zones.
+func checkNodePoolSize(nodePool *NodePool, nodePoolPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if nodePool.Minimum.IsUnknown() || nodePool.Maximum.IsUnknown() || nodePool.Minimum.IsNull() || nodePool.Maximum.IsNull() {
+		return diags
+	}
+	minimum := nodePool.Minimum.ValueInt64()
+	maximum := nodePool.Maximum.ValueInt64()
+	if minimum > maximum {
+		diags.AddAttributeError(nodePoolPath.AtName("minimum"), "Invalid node pool size",
+			fmt.Sprintf("The minimum number of nodes (%d) must not be greater than the maximum number of nodes (%d)", minimum, maximum))
+	}
+
+	if nodePool.AvailabilityZones.IsUnknown() || nodePool.AvailabilityZones.IsNull() {
+		return diags
+	}
+	zones := int64(len(nodePool.AvailabilityZones.Elements()))
+	if maximum < zones {
+		diags.AddAttributeError(nodePoolPath.AtName("maximum"), "Invalid node pool size",
+			fmt.Sprintf("The maximum number of nodes (%d) must be at least the number of availability zones (%d), so that every zone can run a node", maximum, zones))
+	}
+	return diags
+}
+
+// defaultAvailabilityZones picks the availability zones for a node pool without configured zones.
+// The zones of the region are sorted by name, so the result is deterministic, and limited to maximum, so every zone can run a node.
+func defaultAvailabilityZones(availableZones []ske.AvailabilityZone, maximum int64) ([]string, error) {
+	zones := []string{}
+	for _, zone := range availableZones {
+		if zone.Name == nil || *zone.Name == "" {
+			continue
+		}
+		zones = append(zones, *zone.Name)
+	}
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("no availability zones available in the region")
+	}
+	sort.Strings(zones)
+	if maximum > 0 && int64(len(zones)) > maximum {
+		zones = zones[:maximum]
+	}
+	return zones, nil
+}
+
+// setDefaultAvailabilityZones sets the availability zones of the node pools without configured zones.
+func setDefaultAvailabilityZones(model *Cluster, availableZones []ske.AvailabilityZone) error {
+	for i := range model.NodePools {
+		nodePool := &model.NodePools[i]
+		if !nodePool.AvailabilityZones.IsUnknown() && !nodePool.AvailabilityZones.IsNull() {
+			continue
+		}
+		zones, err := defaultAvailabilityZones(availableZones, nodePool.Maximum.ValueInt64())
+		if err != nil {
+			return fmt.Errorf("node pool %q: %w", nodePool.Name.ValueString(), err)
+		}
+		elems := []attr.Value{}
+		for _, zone := range zones {
+			elems = append(elems, types.StringValue(zone))
+		}
+		nodePool.AvailabilityZones = types.ListValueMust(types.StringType, elems)
+	}
+	return nil
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Cluster
@@ -502,12 +710,20 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "name", clusterName)
 
-	availableVersions := r.loadAvaiableVersions(ctx, &resp.Diagnostics)
+	// SKE has to be enabled in the project, which isn't the case if the project can't be read
+	_, err := r.client.GetProject(ctx, projectId).Execute()
+	if err != nil {
+		err = core.ServiceEnablementError(err, "SKE", projectId, "create a stackit_ske_project resource for the project and reference it in the cluster's project_id")
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", err.Error())
+		return
+	}
+
+	options := r.loadProviderOptions(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableVersions)
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, options, r.defaultTimeouts.CreateOr(30*time.Minute))
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -526,7 +742,7 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	tflog.Info(ctx, "SKE cluster created")
 }
 
-func (r *clusterResource) loadAvaiableVersions(ctx context.Context, diags *diag.Diagnostics) []ske.KubernetesVersion {
+func (r *clusterResource) loadProviderOptions(ctx context.Context, diags *diag.Diagnostics) *ske.ProviderOptions {
 	c := r.client
 	res, err := c.GetOptions(ctx).Execute()
 	if err != nil {
@@ -535,25 +751,37 @@ func (r *clusterResource) loadAvaiableVersions(ctx context.Context, diags *diag.
 	}
 
 	if res.KubernetesVersions == nil {
-		diags.AddError("Failed loading cluster available versions: nil kubernetesVersions", err.Error())
+		diags.AddError("Failed loading cluster available versions: nil kubernetesVersions", "")
 		return nil
 	}
 
-	return *res.KubernetesVersions
+	return res
 }
 
-func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnostics, model *Cluster, availableVersions []ske.KubernetesVersion) {
+func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnostics, model *Cluster, options *ske.ProviderOptions, timeout time.Duration) {
 	// cluster vars
 	projectId := model.ProjectId.ValueString()
 	name := model.Name.ValueString()
-	kubernetes, hasDeprecatedVersion, err := toKubernetesPayload(model, availableVersions)
+	kubernetes, hasDeprecatedVersion, err := toKubernetesPayload(model, *options.KubernetesVersions)
 	if err != nil {
 		diags.AddError("Failed to create cluster config payload", err.Error())
 		return
 	}
 	if hasDeprecatedVersion {
 		warningMessage := fmt.Sprintf("Using deprecated kubernetes version %s", *kubernetes.Version)
-		diags.AddWarning(warningMessage, "")
+		core.LogAndAddWarning(ctx, diags, r.strict, warningMessage, "")
+		if diags.HasError() {
+			return
+		}
+	}
+	availableZones := []ske.AvailabilityZone{}
+	if options.AvailabilityZones != nil {
+		availableZones = *options.AvailabilityZones
+	}
+	err = setDefaultAvailabilityZones(model, availableZones)
+	if err != nil {
+		diags.AddError("Failed to set default availability zones", err.Error())
+		return
 	}
 	nodePools := toNodepoolsPayload(ctx, model)
 	maintenance, err := toMaintenancePayload(ctx, model)
@@ -581,9 +809,9 @@ func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag
 		return
 	}
 
-	wr, err := ske.CreateOrUpdateClusterWaitHandler(ctx, r.client, projectId, name).SetTimeout(30 * time.Minute).WaitWithContext(ctx)
+	wr, err := ske.CreateOrUpdateClusterWaitHandler(ctx, r.client, projectId, name).SetTimeout(timeout).WaitWithContext(ctx)
 	if err != nil {
-		diags.AddError("Error creating cluster", fmt.Sprintf("Cluster creation waiting: %v", err))
+		diags.AddError("Error creating cluster", fmt.Sprintf("Cluster creation waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*ske.ClusterResponse)
@@ -792,7 +1020,7 @@ func mapFields(ctx context.Context, cl *ske.ClusterResponse, m *Cluster) error {
 		name,
 	}
 	m.Id = types.StringValue(
-		strings.Join(idParts, core.Separator),
+		core.JoinID(idParts...),
 	)
 
 	if cl.Kubernetes != nil {
@@ -1105,12 +1333,12 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "name", clName)
 
-	availableVersions := r.loadAvaiableVersions(ctx, &resp.Diagnostics)
+	options := r.loadProviderOptions(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableVersions)
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, options, r.defaultTimeouts.UpdateOr(30*time.Minute))
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1143,9 +1371,9 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 		resp.Diagnostics.AddError("failed deleting cluster", err.Error())
 		return
 	}
-	_, err = ske.DeleteClusterWaitHandler(ctx, r.client, projectId, name).SetTimeout(15 * time.Minute).WaitWithContext(ctx)
+	_, err = ske.DeleteClusterWaitHandler(ctx, r.client, projectId, name).SetTimeout(r.defaultTimeouts.DeleteOr(15 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting cluster", fmt.Sprintf("Cluster deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting cluster", fmt.Sprintf("Cluster deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "SKE cluster deleted")
@@ -1154,7 +1382,7 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id,name
 func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
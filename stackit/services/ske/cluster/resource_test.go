@@ -3,9 +3,11 @@ package ske
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/ske"
@@ -633,3 +635,370 @@ func TestCheckAllowPrivilegedContainers(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckNodePoolSize(t *testing.T) {
+	zones := func(names ...string) types.List {
+		elems := []attr.Value{}
+		for _, name := range names {
+			elems = append(elems, types.StringValue(name))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	tests := []struct {
+		description string
+		nodePool    NodePool
+		isValid     bool
+	}{
+		{
+			description: "ok",
+			nodePool: NodePool{
+				Minimum:           types.Int64Value(1),
+				Maximum:           types.Int64Value(3),
+				AvailabilityZones: zones("eu01-1", "eu01-2", "eu01-3"),
+			},
+			isValid: true,
+		},
+		{
+			description: "zones_not_set",
+			nodePool: NodePool{
+				Minimum:           types.Int64Value(1),
+				Maximum:           types.Int64Value(1),
+				AvailabilityZones: types.ListNull(types.StringType),
+			},
+			isValid: true,
+		},
+		{
+			description: "zones_unknown",
+			nodePool: NodePool{
+				Minimum:           types.Int64Value(1),
+				Maximum:           types.Int64Value(1),
+				AvailabilityZones: types.ListUnknown(types.StringType),
+			},
+			isValid: true,
+		},
+		{
+			description: "size_unknown",
+			nodePool: NodePool{
+				Minimum:           types.Int64Unknown(),
+				Maximum:           types.Int64Value(1),
+				AvailabilityZones: zones("eu01-1", "eu01-2"),
+			},
+			isValid: true,
+		},
+		{
+			description: "minimum_greater_than_maximum",
+			nodePool: NodePool{
+				Minimum:           types.Int64Value(3),
+				Maximum:           types.Int64Value(2),
+				AvailabilityZones: zones("eu01-1"),
+			},
+			isValid: false,
+		},
+		{
+			description: "maximum_less_than_zones",
+			nodePool: NodePool{
+				Minimum:           types.Int64Value(1),
+				Maximum:           types.Int64Value(2),
+				AvailabilityZones: zones("eu01-1", "eu01-2", "eu01-3"),
+			},
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			nodePool := tt.nodePool
+			diags := checkNodePoolSize(&nodePool, path.Root("node_pools").AtListIndex(0))
+
+			if tt.isValid && diags.HasError() {
+				t.Errorf("checkNodePoolSize failed on valid input: %v", core.DiagsToError(diags))
+			}
+			if !tt.isValid && !diags.HasError() {
+				t.Errorf("checkNodePoolSize didn't fail on invalid input")
+			}
+		})
+	}
+}
+
+func TestDefaultAvailabilityZones(t *testing.T) {
+	tests := []struct {
+		description    string
+		availableZones []ske.AvailabilityZone
+		maximum        int64
+		expected       []string
+		isValid        bool
+	}{
+		{
+			description: "sorted",
+			availableZones: []ske.AvailabilityZone{
+				{Name: utils.Ptr("eu01-3")},
+				{Name: utils.Ptr("eu01-1")},
+				{Name: utils.Ptr("eu01-2")},
+			},
+			maximum:  3,
+			expected: []string{"eu01-1", "eu01-2", "eu01-3"},
+			isValid:  true,
+		},
+		{
+			description: "limited_by_maximum",
+			availableZones: []ske.AvailabilityZone{
+				{Name: utils.Ptr("eu01-3")},
+				{Name: utils.Ptr("eu01-1")},
+				{Name: utils.Ptr("eu01-2")},
+			},
+			maximum:  2,
+			expected: []string{"eu01-1", "eu01-2"},
+			isValid:  true,
+		},
+		{
+			description: "skip_empty_names",
+			availableZones: []ske.AvailabilityZone{
+				{Name: nil},
+				{Name: utils.Ptr("")},
+				{Name: utils.Ptr("eu01-m")},
+			},
+			maximum:  3,
+			expected: []string{"eu01-m"},
+			isValid:  true,
+		},
+		{
+			description:    "no_zones",
+			availableZones: []ske.AvailabilityZone{},
+			maximum:        3,
+			isValid:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := defaultAvailabilityZones(tt.availableZones, tt.maximum)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAvailabilityZonesFromState(t *testing.T) {
+	zones := func(names ...string) types.List {
+		elems := []attr.Value{}
+		for _, name := range names {
+			elems = append(elems, types.StringValue(name))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	nodePool := func(name string, maximum int64, availabilityZones types.List) NodePool {
+		return NodePool{
+			Name:              types.StringValue(name),
+			Maximum:           types.Int64Value(maximum),
+			AvailabilityZones: availabilityZones,
+		}
+	}
+
+	tests := []struct {
+		description    string
+		planNodePools  []NodePool
+		stateNodePools []NodePool
+		expected       map[int]types.List
+	}{
+		{
+			"same_order",
+			[]NodePool{
+				nodePool("a", 3, types.ListUnknown(types.StringType)),
+				nodePool("b", 3, types.ListUnknown(types.StringType)),
+			},
+			[]NodePool{
+				nodePool("a", 3, zones("eu01-1", "eu01-2")),
+				nodePool("b", 3, zones("eu01-3")),
+			},
+			map[int]types.List{
+				0: zones("eu01-1", "eu01-2"),
+				1: zones("eu01-3"),
+			},
+		},
+		{
+			"pool_inserted_before",
+			[]NodePool{
+				nodePool("new", 3, types.ListUnknown(types.StringType)),
+				nodePool("a", 3, types.ListUnknown(types.StringType)),
+			},
+			[]NodePool{
+				nodePool("a", 3, zones("eu01-1", "eu01-2")),
+			},
+			map[int]types.List{
+				1: zones("eu01-1", "eu01-2"),
+			},
+		},
+		{
+			"pool_removed",
+			[]NodePool{
+				nodePool("b", 3, types.ListUnknown(types.StringType)),
+			},
+			[]NodePool{
+				nodePool("a", 3, zones("eu01-1", "eu01-2")),
+				nodePool("b", 3, zones("eu01-3")),
+			},
+			map[int]types.List{
+				0: zones("eu01-3"),
+			},
+		},
+		{
+			"configured_zones",
+			[]NodePool{
+				nodePool("a", 3, zones("eu01-m")),
+			},
+			[]NodePool{
+				nodePool("a", 3, zones("eu01-1", "eu01-2")),
+			},
+			map[int]types.List{},
+		},
+		{
+			"maximum_lowered",
+			[]NodePool{
+				nodePool("a", 1, types.ListUnknown(types.StringType)),
+			},
+			[]NodePool{
+				nodePool("a", 3, zones("eu01-1", "eu01-2")),
+			},
+			map[int]types.List{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := availabilityZonesFromState(tt.planNodePools, tt.stateNodePools)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckVersionExpiration(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description       string
+		availableVersions []ske.KubernetesVersion
+		providedVersion   *string
+		warningDays       int64
+		expectedWarning   string
+		isValid           bool
+	}{
+		{
+			"expires_soon",
+			[]ske.KubernetesVersion{
+				{
+					Version:        utils.Ptr("1.25.0"),
+					State:          utils.Ptr(VersionStateDeprecated),
+					ExpirationDate: utils.Ptr("2023-10-01T00:00:00Z"),
+				},
+				{
+					Version:        utils.Ptr("1.25.1"),
+					State:          utils.Ptr(VersionStateSupported),
+					ExpirationDate: utils.Ptr("2023-10-20T00:00:00Z"),
+				},
+				{
+					Version: utils.Ptr("1.26.0"),
+					State:   utils.Ptr(VersionStateSupported),
+				},
+			},
+			utils.Ptr("1.25"),
+			30,
+			"Kubernetes version 1.25.1 expires on 2023-10-20",
+			true,
+		},
+		{
+			"expired",
+			[]ske.KubernetesVersion{
+				{
+					Version:        utils.Ptr("1.25.1"),
+					State:          utils.Ptr(VersionStateDeprecated),
+					ExpirationDate: utils.Ptr("2023-09-30T00:00:00Z"),
+				},
+			},
+			utils.Ptr("1.25.1"),
+			30,
+			"Kubernetes version 1.25.1 expired on 2023-09-30",
+			true,
+		},
+		{
+			"expires_later",
+			[]ske.KubernetesVersion{
+				{
+					Version:        utils.Ptr("1.25.1"),
+					State:          utils.Ptr(VersionStateSupported),
+					ExpirationDate: utils.Ptr("2023-12-01T00:00:00Z"),
+				},
+			},
+			utils.Ptr("1.25"),
+			30,
+			"",
+			true,
+		},
+		{
+			"no_expiration_date",
+			[]ske.KubernetesVersion{
+				{
+					Version: utils.Ptr("1.25.1"),
+					State:   utils.Ptr(VersionStateSupported),
+				},
+			},
+			utils.Ptr("1.25"),
+			30,
+			"",
+			true,
+		},
+		{
+			"invalid_expiration_date",
+			[]ske.KubernetesVersion{
+				{
+					Version:        utils.Ptr("1.25.1"),
+					State:          utils.Ptr(VersionStateSupported),
+					ExpirationDate: utils.Ptr("2023-10-20"),
+				},
+			},
+			utils.Ptr("1.25"),
+			30,
+			"",
+			false,
+		},
+		{
+			"unavailable_version",
+			[]ske.KubernetesVersion{
+				{
+					Version:        utils.Ptr("1.25.1"),
+					State:          utils.Ptr(VersionStateSupported),
+					ExpirationDate: utils.Ptr("2023-10-20T00:00:00Z"),
+				},
+			},
+			utils.Ptr("1.24"),
+			30,
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			warning, err := checkVersionExpiration(tt.availableVersions, tt.providedVersion, tt.warningDays, now)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if warning != tt.expectedWarning {
+				t.Fatalf("Warning does not match: expecting %q, got %q", tt.expectedWarning, warning)
+			}
+		})
+	}
+}
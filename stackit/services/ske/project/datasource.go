@@ -52,12 +52,12 @@ func (r *projectDataSource) Configure(ctx context.Context, req datasource.Config
 	var err error
 	if providerData.SKECustomEndpoint != "" {
 		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("ske")),
 			config.WithEndpoint(providerData.SKECustomEndpoint),
 		)
 	} else {
 		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("ske")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -83,7 +83,7 @@ func (r *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "STACKIT Project ID in which the kubernetes project is enabled.",
 				Required:    true,
 				Validators: []validator.String{
-					validate.UUID(),
+					validate.DataSourceUUID(),
 					validate.NoSeparator(),
 				},
 			},
@@ -99,6 +99,8 @@ func (r *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// IDs passed from other providers may not be in the canonical format of the API
+	state.ProjectId = core.CanonicalizeIDValue(state.ProjectId)
 
 	projectId := state.ProjectId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
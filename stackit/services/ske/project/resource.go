@@ -3,7 +3,6 @@ package ske
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -39,7 +38,8 @@ func NewProjectResource() resource.Resource {
 
 // projectResource is the resource implementation.
 type projectResource struct {
-	client *ske.APIClient
+	client          *ske.APIClient
+	defaultTimeouts core.Timeouts
 }
 
 // Metadata returns the resource type name.
@@ -64,12 +64,12 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 	var err error
 	if providerData.SKECustomEndpoint != "" {
 		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("ske")),
 			config.WithEndpoint(providerData.SKECustomEndpoint),
 		)
 	} else {
 		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithCustomAuth(providerData.ServiceRoundTripper("ske")),
 			config.WithRegion(providerData.Region),
 		)
 	}
@@ -81,6 +81,7 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 
 	tflog.Info(ctx, "SKE project client configured")
 	r.client = apiClient
+	r.defaultTimeouts = providerData.DefaultTimeouts
 }
 
 // Schema returns the Terraform schema structure
@@ -124,9 +125,9 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	model.Id = types.StringValue(projectId)
-	wr, err := ske.CreateProjectWaitHandler(ctx, r.client, projectId).SetTimeout(5 * time.Minute).WaitWithContext(ctx)
+	wr, err := ske.CreateProjectWaitHandler(ctx, r.client, projectId).SetTimeout(r.defaultTimeouts.CreateOr(5 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating cluster", fmt.Sprintf("Project creation waiting: %v", err))
+		resp.Diagnostics.AddError("Error creating cluster", fmt.Sprintf("Project creation waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	got, ok := wr.(*ske.ProjectResponse)
@@ -186,9 +187,9 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		resp.Diagnostics.AddError("failed deleting project", err.Error())
 		return
 	}
-	_, err = ske.DeleteProjectWaitHandler(ctx, r.client, projectId).SetTimeout(10 * time.Minute).WaitWithContext(ctx)
+	_, err = ske.DeleteProjectWaitHandler(ctx, r.client, projectId).SetTimeout(r.defaultTimeouts.DeleteOr(10 * time.Minute)).WaitWithContext(ctx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting project", fmt.Sprintf("Project deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting project", fmt.Sprintf("Project deletion waiting: %v", core.WaitError(ctx, err)))
 		return
 	}
 	tflog.Info(ctx, "SKE project deleted")
@@ -197,7 +198,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 // ImportState imports a resource into the Terraform state on success.
 // The expected format of the resource import identifier is: project_id
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) { // nolint:gocritic // function signature required by Terraform
-	idParts := strings.Split(req.ID, core.Separator)
+	idParts := core.SplitID(req.ID)
 	if len(idParts) != 1 || idParts[0] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
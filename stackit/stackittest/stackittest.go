@@ -0,0 +1,134 @@
+// Package stackittest provides helpers to write Terraform acceptance tests against real STACKIT projects
+// with this provider, e.g. for modules built on top of it. The acceptance tests of the provider use it as well.
+package stackittest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
+)
+
+const (
+	// ProjectIdEnv is the env var holding the ID of the project in which the test resources are created
+	ProjectIdEnv = "TF_ACC_PROJECT_ID"
+	// ProjectParentContainerIdEnv is the env var holding the container ID of the organization under which test projects are created
+	ProjectParentContainerIdEnv = "TF_ACC_TEST_PROJECT_PARENT_CONTAINER_ID"
+	// ProjectServiceAccountEmailEnv is the env var holding the e-mail of a service account allowed to create projects under the parent container
+	ProjectServiceAccountEmailEnv = "TF_ACC_TEST_PROJECT_SERVICE_ACCOUNT_EMAIL"
+)
+
+// ProtoV6ProviderFactories returns the provider factories for resource.TestCase.ProtoV6ProviderFactories.
+// The factory function is invoked for every Terraform CLI command executed to create a provider server
+// to which the CLI can reattach.
+func ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"stackit": providerserver.NewProtocol6WithError(stackit.New("test-version")()),
+	}
+}
+
+// ProjectId returns the ID of the project in which the test resources are created, set by the TF_ACC_PROJECT_ID env var.
+func ProjectId() string {
+	return os.Getenv(ProjectIdEnv)
+}
+
+// PreCheck fails the test if the env vars required to run acceptance tests in a project are not set.
+// It is meant to be used as resource.TestCase.PreCheck.
+func PreCheck(t *testing.T) {
+	t.Helper()
+	if ProjectId() == "" {
+		t.Fatalf("The env var %s must be set to run acceptance tests", ProjectIdEnv)
+	}
+}
+
+// ResourceName returns a name for a test resource, made of the prefix and the current time,
+// e.g. "tf-acc-dns-2023-10-01T12:00:00". The "tf-acc-" prefix eases cleaning up leftovers of failed tests.
+func ResourceName(prefix string) string {
+	dateTime := time.Now().Format(time.RFC3339)
+	// Remove timezone to have a smaller datetime
+	dateTimeTrimmed, _, _ := strings.Cut(dateTime, "+")
+	return fmt.Sprintf("tf-acc-%s-%s", prefix, dateTimeTrimmed)
+}
+
+// RandomResourceName returns a name for a test resource, made of the prefix and n random alphanumeric characters,
+// e.g. "tf-acc-pj-a1b2c". It suits resources whose names are short or can't contain colons.
+func RandomResourceName(prefix string, n int) string {
+	return fmt.Sprintf("tf-acc-%s-%s", prefix, acctest.RandStringFromCharSet(n, acctest.CharSetAlphaNum))
+}
+
+// ProjectConfig returns the configuration of a stackit_resourcemanager_project resource named resourceName,
+// which creates a project called projectName as a fixture for tests that need a project of their own.
+// The project is created in the container set by TF_ACC_TEST_PROJECT_PARENT_CONTAINER_ID and owned by the
+// service account set by TF_ACC_TEST_PROJECT_SERVICE_ACCOUNT_EMAIL.
+func ProjectConfig(resourceName, projectName string) string {
+	return fmt.Sprintf(`
+		resource "stackit_resourcemanager_project" "%s" {
+			parent_container_id = "%s"
+			name                = "%s"
+			owner_email         = "%s"
+		}`,
+		resourceName,
+		os.Getenv(ProjectParentContainerIdEnv),
+		projectName,
+		os.Getenv(ProjectServiceAccountEmailEnv),
+	)
+}
+
+// ExistsFunc reports whether the remote object of a resource in the Terraform state still exists.
+type ExistsFunc func(ctx context.Context, rs *terraform.ResourceState) (bool, error)
+
+// CheckDestroy returns a check for resource.TestCase.CheckDestroy that fails if the remote object of any resource
+// of the given type still exists after the resources were destroyed.
+func CheckDestroy(resourceType string, exists ExistsFunc) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			found, err := exists(ctx, rs)
+			if err != nil {
+				return fmt.Errorf("checking if %s was destroyed: %w", name, err)
+			}
+			if found {
+				return fmt.Errorf("%s with ID %q still exists", name, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// ExistsFromError interprets the error of getting a remote object in an ExistsFunc:
+// the object exists if there is no error, and doesn't exist if the API responded with 404.
+func ExistsFromError(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	if core.IsNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// IdParts splits the Terraform ID of a resource, e.g. "[project_id],[instance_id]", into its n parts.
+func IdParts(rs *terraform.ResourceState, n int) ([]string, error) {
+	if rs == nil || rs.Primary == nil {
+		return nil, fmt.Errorf("resource has no primary instance")
+	}
+	parts := core.SplitID(rs.Primary.ID)
+	if len(parts) != n {
+		return nil, fmt.Errorf("expected ID with %d parts separated by %q, got %q", n, core.Separator, rs.Primary.ID)
+	}
+	return parts, nil
+}
@@ -0,0 +1,135 @@
+package stackittest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+type apiError struct {
+	statusCode int
+}
+
+func (e apiError) Error() string {
+	return fmt.Sprintf("status code %d", e.statusCode)
+}
+
+func (e apiError) StatusCode() int {
+	return e.statusCode
+}
+
+func TestCheckDestroy(t *testing.T) {
+	state := &terraform.State{
+		Modules: []*terraform.ModuleState{
+			{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.ResourceState{
+					"stackit_dns_zone.zone": {
+						Type:    "stackit_dns_zone",
+						Primary: &terraform.InstanceState{ID: "pid,zid"},
+					},
+					"stackit_dns_record_set.record": {
+						Type:    "stackit_dns_record_set",
+						Primary: &terraform.InstanceState{ID: "pid,zid,rid"},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		description string
+		exists      ExistsFunc
+		isValid     bool
+	}{
+		{
+			"destroyed",
+			func(_ context.Context, _ *terraform.ResourceState) (bool, error) { return false, nil },
+			true,
+		},
+		{
+			"still_exists",
+			func(_ context.Context, _ *terraform.ResourceState) (bool, error) { return true, nil },
+			false,
+		},
+		{
+			"error",
+			func(_ context.Context, _ *terraform.ResourceState) (bool, error) { return false, fmt.Errorf("error") },
+			false,
+		},
+		{
+			"other_type_exists",
+			func(_ context.Context, rs *terraform.ResourceState) (bool, error) {
+				return rs.Type != "stackit_dns_zone", nil
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := CheckDestroy("stackit_dns_zone", tt.exists)(state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestExistsFromError(t *testing.T) {
+	tests := []struct {
+		description string
+		input       error
+		expected    bool
+		isValid     bool
+	}{
+		{"no_error", nil, true, true},
+		{"not_found", &apiError{statusCode: http.StatusNotFound}, false, true},
+		{"other_error", &apiError{statusCode: http.StatusInternalServerError}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			exists, err := ExistsFromError(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if exists != tt.expected {
+				t.Fatalf("Expected exists to be %t, got %t", tt.expected, exists)
+			}
+		})
+	}
+}
+
+func TestIdParts(t *testing.T) {
+	rs := &terraform.ResourceState{Primary: &terraform.InstanceState{ID: "pid,iid"}}
+	parts, err := IdParts(rs, 2)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if parts[0] != "pid" || parts[1] != "iid" {
+		t.Fatalf("Unexpected parts %v", parts)
+	}
+	if _, err := IdParts(rs, 3); err == nil {
+		t.Fatalf("Should have failed")
+	}
+	if _, err := IdParts(&terraform.ResourceState{}, 2); err == nil {
+		t.Fatalf("Should have failed")
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	if name := ResourceName("dns"); !regexp.MustCompile(`^tf-acc-dns-\d{4}-\d{2}-\d{2}T`).MatchString(name) {
+		t.Fatalf("Unexpected name %q", name)
+	}
+	if name := RandomResourceName("pj", 5); !regexp.MustCompile(`^tf-acc-pj-[a-zA-Z0-9]{5}$`).MatchString(name) {
+		t.Fatalf("Unexpected name %q", name)
+	}
+}
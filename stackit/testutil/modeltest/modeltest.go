@@ -0,0 +1,96 @@
+// Package modeltest contains helpers for the unit tests of the resource and data source models.
+// It is kept apart from testutil, which imports the provider and can't be used by the service packages' unit tests.
+package modeltest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CheckDataSourceModel checks that toDataSourceModel copies every attribute of the resource model R to the
+// data source model D, except the excluded ones, which must not be part of D.
+// It fills every attribute of R with a distinct value, so a field added to R and not to D, or not copied, fails the test.
+func CheckDataSourceModel[R, D any](t *testing.T, toDataSourceModel func(*R) D, excluded ...string) {
+	t.Helper()
+
+	isExcluded := map[string]bool{}
+	for _, name := range excluded {
+		isExcluded[name] = true
+	}
+
+	var model R
+	modelValue := reflect.ValueOf(&model).Elem()
+	modelFields := map[string]reflect.Value{}
+	for i := 0; i < modelValue.NumField(); i++ {
+		name := modelValue.Type().Field(i).Tag.Get("tfsdk")
+		if name == "" {
+			continue
+		}
+		modelFields[name] = modelValue.Field(i)
+		if isExcluded[name] {
+			continue
+		}
+		value, ok := testValue(modelValue.Field(i).Type(), name, int64(i))
+		if !ok {
+			t.Fatalf("attribute %q has the unsupported type %s", name, modelValue.Field(i).Type())
+		}
+		modelValue.Field(i).Set(reflect.ValueOf(value))
+	}
+	for name := range isExcluded {
+		if _, ok := modelFields[name]; !ok {
+			t.Errorf("excluded attribute %q isn't part of the resource model", name)
+		}
+	}
+
+	dataSourceModel := toDataSourceModel(&model)
+	dataSourceValue := reflect.ValueOf(dataSourceModel)
+	dataSourceFields := map[string]bool{}
+	for i := 0; i < dataSourceValue.NumField(); i++ {
+		name := dataSourceValue.Type().Field(i).Tag.Get("tfsdk")
+		if name == "" {
+			continue
+		}
+		dataSourceFields[name] = true
+		modelField, ok := modelFields[name]
+		switch {
+		case !ok:
+			t.Errorf("attribute %q of the data source model isn't part of the resource model", name)
+		case isExcluded[name]:
+			t.Errorf("excluded attribute %q is part of the data source model", name)
+		case !reflect.DeepEqual(modelField.Interface(), dataSourceValue.Field(i).Interface()):
+			t.Errorf("attribute %q isn't copied: expected %v, got %v", name, modelField.Interface(), dataSourceValue.Field(i).Interface())
+		}
+	}
+	for name := range modelFields {
+		if !isExcluded[name] && !dataSourceFields[name] {
+			t.Errorf("attribute %q of the resource model is missing in the data source model", name)
+		}
+	}
+}
+
+// testValue returns a known value of the given type that is distinct for every attribute
+func testValue(valueType reflect.Type, name string, index int64) (attr.Value, bool) {
+	switch valueType {
+	case reflect.TypeOf(types.String{}):
+		return types.StringValue(name), true
+	case reflect.TypeOf(types.Int64{}):
+		return types.Int64Value(index + 1), true
+	case reflect.TypeOf(types.Bool{}):
+		return types.BoolValue(true), true
+	case reflect.TypeOf(types.List{}):
+		return types.ListValueMust(types.StringType, []attr.Value{types.StringValue(name)}), true
+	case reflect.TypeOf(types.Set{}):
+		return types.SetValueMust(types.StringType, []attr.Value{types.StringValue(name)}), true
+	case reflect.TypeOf(types.Map{}):
+		return types.MapValueMust(types.StringType, map[string]attr.Value{name: types.StringValue(name)}), true
+	case reflect.TypeOf(types.Object{}):
+		return types.ObjectValueMust(
+			map[string]attr.Type{name: types.StringType},
+			map[string]attr.Value{name: types.StringValue(name)},
+		), true
+	}
+	return nil, false
+}
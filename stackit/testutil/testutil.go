@@ -5,13 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
-	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-
-	"github.com/stackitcloud/terraform-provider-stackit/stackit"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/stackittest"
 )
 
 const (
@@ -24,16 +19,14 @@ var (
 	// acceptance testing. The factory function will be invoked for every Terraform
 	// CLI command executed to create a provider server to which the CLI can
 	// reattach.
-	TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		"stackit": providerserver.NewProtocol6WithError(stackit.New("test-version")()),
-	}
+	TestAccProtoV6ProviderFactories = stackittest.ProtoV6ProviderFactories()
 
 	// ProjectId is the id of project used for tests
-	ProjectId = os.Getenv("TF_ACC_PROJECT_ID")
+	ProjectId = stackittest.ProjectId()
 	// TestProjectParentContainerID is the container id of the organization under which projects are created as part of the resource-manager acceptance tests
-	TestProjectParentContainerID = os.Getenv("TF_ACC_TEST_PROJECT_PARENT_CONTAINER_ID")
+	TestProjectParentContainerID = os.Getenv(stackittest.ProjectParentContainerIdEnv)
 	// TestProjectServiceAccountEmail is the e-mail of a service account with admin permissions on the organization under which projects are created as part of the resource-manager acceptance tests
-	TestProjectServiceAccountEmail = os.Getenv("TF_ACC_TEST_PROJECT_SERVICE_ACCOUNT_EMAIL")
+	TestProjectServiceAccountEmail = os.Getenv(stackittest.ProjectServiceAccountEmailEnv)
 
 	ArgusCustomEndpoint           = os.Getenv("TF_ACC_ARGUS_CUSTOM_ENDPOINT")
 	DnsCustomEndpoint             = os.Getenv("TF_ACC_DNS_CUSTOM_ENDPOINT")
@@ -219,10 +212,7 @@ func SKEProviderConfig() string {
 }
 
 func ResourceNameWithDateTime(name string) string {
-	dateTime := time.Now().Format(time.RFC3339)
-	// Remove timezone to have a smaller datetime
-	dateTimeTrimmed, _, _ := strings.Cut(dateTime, "+")
-	return fmt.Sprintf("tf-acc-%s-%s", name, dateTimeTrimmed)
+	return stackittest.ResourceName(name)
 }
 
 func getTestProjectServiceAccountToken(path string) string {
@@ -4,11 +4,17 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/mail"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
 )
 
@@ -48,6 +54,20 @@ func UUID() *Validator {
 	}
 }
 
+// DataSourceUUID validates that the string is a UUID after canonicalization with core.CanonicalizeID.
+// It is meant for the IDs data sources are looked up by, which are often passed from other providers
+// in a different format, e.g. in upper case or as URN. The data sources canonicalize them before use.
+func DataSourceUUID() *Validator {
+	return &Validator{
+		description: "validate string is UUID, possibly in upper case or as URN",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if _, err := uuid.Parse(core.CanonicalizeID(req.ConfigValue.ValueString())); err != nil {
+				resp.Diagnostics.AddError("not a valid UUID", err.Error())
+			}
+		},
+	}
+}
+
 func IP() *Validator {
 	return &Validator{
 		description: "validate string is IP address",
@@ -59,6 +79,292 @@ func IP() *Validator {
 	}
 }
 
+// CIDRList validates that the string is a comma-separated list of IPv4 or IPv6 networks in CIDR notation.
+// Whitespace around the entries is ignored. E.g. `192.168.0.0/24,2001:db8::/32`
+func CIDRList() *Validator {
+	return &Validator{
+		description: "validate string is a comma-separated list of CIDR networks",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			validateCIDRList(req.ConfigValue.ValueString(), false, resp)
+		},
+	}
+}
+
+// SgwACL validates that the string is a valid service gateway ACL of a data service instance,
+// i.e. a comma-separated list of CIDR networks like CIDRList, where each entry has to be given by its network address.
+// E.g. `192.168.0.0/24` is valid, but `192.168.0.1/24` is rejected by the data services.
+func SgwACL() *Validator {
+	return &Validator{
+		description: "validate string is a comma-separated list of CIDR networks given by their network address",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			validateCIDRList(req.ConfigValue.ValueString(), true, resp)
+		},
+	}
+}
+
+func validateCIDRList(value string, requireNetworkAddress bool, resp *validator.StringResponse) {
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		ip, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			resp.Diagnostics.AddError("not a valid list of CIDR networks", fmt.Sprintf("Entry %q of %q is not a network in CIDR notation, e.g. `192.168.0.0/24`", entry, value))
+			continue
+		}
+		if requireNetworkAddress && !ip.Equal(network.IP) {
+			resp.Diagnostics.AddError("not a valid list of CIDR networks", fmt.Sprintf("Entry %q of %q has host bits set, use its network address %q instead", entry, value, network.String()))
+		}
+	}
+}
+
+// RecordSetName validates that the string is a fully qualified domain name according to RFC 1035.
+// The name may have at most 253 characters (excluding a trailing dot) and each label at most 63 characters.
+// A wildcard (`*`) is only allowed as the complete leftmost label, e.g. `*.example.com`.
+func RecordSetName() *Validator {
+	return &Validator{
+		description: "validate string is a valid record set name",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if err := validateDomainName(req.ConfigValue.ValueString(), true); err != nil {
+				resp.Diagnostics.AddError("not a valid record set name", err.Error())
+			}
+		},
+	}
+}
+
+var domainLabelRegex = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]*[a-zA-Z0-9_])?$`)
+
+func validateDomainName(name string, allowWildcard bool) error {
+	fqdn := strings.TrimSuffix(name, ".")
+	if fqdn == "" {
+		return fmt.Errorf("the name must not be empty")
+	}
+	if len(fqdn) > 253 {
+		return fmt.Errorf("the name must have at most 253 characters, got %d", len(fqdn))
+	}
+	labels := strings.Split(fqdn, ".")
+	for i, label := range labels {
+		if label == "*" {
+			if !allowWildcard {
+				return fmt.Errorf("wildcard labels are not allowed")
+			}
+			if i != 0 {
+				return fmt.Errorf("the wildcard `*` is only allowed as the leftmost label, got %q", name)
+			}
+			continue
+		}
+		if label == "" {
+			return fmt.Errorf("the name must not contain empty labels, got %q", name)
+		}
+		if len(label) > 63 {
+			return fmt.Errorf("label %q must have at most 63 characters, got %d", label, len(label))
+		}
+		if !domainLabelRegex.MatchString(label) {
+			return fmt.Errorf("label %q must only contain letters, digits, hyphens and underscores and must not start or end with a hyphen", label)
+		}
+	}
+	return nil
+}
+
+func IPv4() *Validator {
+	return &Validator{
+		description: "validate string is IPv4 address",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			ip := net.ParseIP(req.ConfigValue.ValueString())
+			if ip == nil || ip.To4() == nil {
+				resp.Diagnostics.AddError("not a valid IPv4 address", fmt.Sprintf("Got %q", req.ConfigValue.ValueString()))
+			}
+		},
+	}
+}
+
+func IPv6() *Validator {
+	return &Validator{
+		description: "validate string is IPv6 address",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			value := req.ConfigValue.ValueString()
+			if net.ParseIP(value) == nil || !strings.Contains(value, ":") {
+				resp.Diagnostics.AddError("not a valid IPv6 address", fmt.Sprintf("Got %q", value))
+			}
+		},
+	}
+}
+
+// Hostname validates that the string is a domain name according to RFC 1035, without wildcards.
+func Hostname() *Validator {
+	return &Validator{
+		description: "validate string is a valid hostname",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if err := validateDomainName(req.ConfigValue.ValueString(), false); err != nil {
+				resp.Diagnostics.AddError("not a valid hostname", err.Error())
+			}
+		},
+	}
+}
+
+// Email validates that the string is a plain e-mail address, without display name. E.g. `hostmaster@example.com`
+func Email() *Validator {
+	return &Validator{
+		description: "validate string is a valid e-mail address",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			address, err := mail.ParseAddress(req.ConfigValue.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("not a valid e-mail address", err.Error())
+				return
+			}
+			if address.Address != req.ConfigValue.ValueString() {
+				resp.Diagnostics.AddError("not a valid e-mail address", fmt.Sprintf("%q must be a plain e-mail address, without display name or angle brackets", req.ConfigValue.ValueString()))
+			}
+		},
+	}
+}
+
+// Duration validates that the string is a positive duration, as accepted by time.ParseDuration. E.g. `30m` or `1h30m`
+func Duration() *Validator {
+	return &Validator{
+		description: "validate string is a positive duration",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			d, err := time.ParseDuration(req.ConfigValue.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("not a valid duration", fmt.Sprintf("%q must be a duration like `30m` or `1h30m`: %v", req.ConfigValue.ValueString(), err))
+				return
+			}
+			if d <= 0 {
+				resp.Diagnostics.AddError("not a valid duration", fmt.Sprintf("%q must be a positive duration", req.ConfigValue.ValueString()))
+			}
+		},
+	}
+}
+
+// MXRecord validates that the string is MX record content, in the format `<preference> <exchange>`. E.g. `10 mail.example.com.`
+func MXRecord() *Validator {
+	return &Validator{
+		description: "validate string is a valid MX record",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			value := req.ConfigValue.ValueString()
+			fields := strings.Fields(value)
+			if len(fields) != 2 {
+				resp.Diagnostics.AddError("not a valid MX record", fmt.Sprintf("The record should have the format `<preference> <exchange>`, e.g. `10 mail.example.com.`. Got %q", value))
+				return
+			}
+			if err := validateUint16(fields[0]); err != nil {
+				resp.Diagnostics.AddError("not a valid MX record", fmt.Sprintf("Invalid preference: %v", err))
+			}
+			if err := validateDomainName(fields[1], false); err != nil {
+				resp.Diagnostics.AddError("not a valid MX record", fmt.Sprintf("Invalid exchange: %v", err))
+			}
+		},
+	}
+}
+
+// SRVRecord validates that the string is SRV record content, in the format `<priority> <weight> <port> <target>`. E.g. `10 5 5060 sip.example.com.`
+func SRVRecord() *Validator {
+	return &Validator{
+		description: "validate string is a valid SRV record",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			value := req.ConfigValue.ValueString()
+			fields := strings.Fields(value)
+			if len(fields) != 4 {
+				resp.Diagnostics.AddError("not a valid SRV record", fmt.Sprintf("The record should have the format `<priority> <weight> <port> <target>`, e.g. `10 5 5060 sip.example.com.`. Got %q", value))
+				return
+			}
+			for i, field := range []string{"priority", "weight", "port"} {
+				if err := validateUint16(fields[i]); err != nil {
+					resp.Diagnostics.AddError("not a valid SRV record", fmt.Sprintf("Invalid %s: %v", field, err))
+				}
+			}
+			// A target of "." means that the service is decidedly not available at this domain
+			if fields[3] == "." {
+				return
+			}
+			if err := validateDomainName(fields[3], false); err != nil {
+				resp.Diagnostics.AddError("not a valid SRV record", fmt.Sprintf("Invalid target: %v", err))
+			}
+		},
+	}
+}
+
+var caaRecordRegex = regexp.MustCompile(`^(\d+)\s+([a-zA-Z0-9]+)\s+(.+)$`)
+
+// CAARecord validates that the string is CAA record content, in the format `<flags> <tag> <value>`. E.g. `0 issue "letsencrypt.org"`
+func CAARecord() *Validator {
+	return &Validator{
+		description: "validate string is a valid CAA record",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			value := req.ConfigValue.ValueString()
+			matches := caaRecordRegex.FindStringSubmatch(value)
+			if matches == nil {
+				resp.Diagnostics.AddError("not a valid CAA record", fmt.Sprintf("The record should have the format `<flags> <tag> <value>`, e.g. `0 issue \"letsencrypt.org\"`. Got %q", value))
+				return
+			}
+			flags, err := strconv.Atoi(matches[1])
+			if err != nil || flags > 255 {
+				resp.Diagnostics.AddError("not a valid CAA record", fmt.Sprintf("The flags should be a number between 0 and 255, got %q", matches[1]))
+			}
+		},
+	}
+}
+
+// DNSRecordValidators returns the validators that apply to each record of a DNS record set of the given type
+func DNSRecordValidators(recordType string) []validator.String {
+	switch strings.ToUpper(recordType) {
+	case "A":
+		return []validator.String{IPv4()}
+	case "AAAA":
+		return []validator.String{IPv6()}
+	case "CNAME", "NS", "PTR", "DNAME", "ALIAS":
+		return []validator.String{Hostname()}
+	case "MX":
+		return []validator.String{MXRecord()}
+	case "SRV":
+		return []validator.String{SRVRecord()}
+	case "CAA":
+		return []validator.String{CAARecord()}
+	default:
+		// TXT and other record types accept free text
+		return nil
+	}
+}
+
+// DNSRecords validates the records of a DNS record set of the given type, reporting errors at recordsPath
+func DNSRecords(ctx context.Context, recordType types.String, records types.Set, recordsPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The type may be unknown at validation time or left to the API default
+	if recordType.IsUnknown() || recordType.IsNull() || records.IsUnknown() || records.IsNull() {
+		return diags
+	}
+	validators := DNSRecordValidators(recordType.ValueString())
+	for i, record := range records.Elements() {
+		recordString, ok := record.(types.String)
+		if !ok {
+			diags.AddError("Invalid record", fmt.Sprintf("expected record at index %d to be of type %T, got %T", i, types.String{}, record))
+			continue
+		}
+		for _, v := range validators {
+			validatorResp := validator.StringResponse{}
+			v.ValidateString(ctx, validator.StringRequest{
+				Path:        recordsPath.AtSetValue(recordString),
+				ConfigValue: recordString,
+			}, &validatorResp)
+			for _, d := range validatorResp.Diagnostics {
+				diags.AddAttributeError(
+					recordsPath.AtSetValue(recordString),
+					d.Summary(),
+					fmt.Sprintf("Invalid record for record set type %q. %s", recordType.ValueString(), d.Detail()),
+				)
+			}
+		}
+	}
+	return diags
+}
+
+func validateUint16(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 || n > 65535 {
+		return fmt.Errorf("expected a number between 0 and 65535, got %q", value)
+	}
+	return nil
+}
+
 func NoSeparator() *Validator {
 	return &Validator{
 		description: "validate string does not contain internal separator",
@@ -2,10 +2,14 @@ package validate
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/core"
 )
 
 func TestUUID(t *testing.T) {
@@ -101,6 +105,635 @@ func TestIP(t *testing.T) {
 	}
 }
 
+func TestIPv4(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"111.222.111.222",
+			true,
+		},
+		{
+			"IP6",
+			"2001:0db8:85a3:08d3::0370:7344",
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+		{
+			"Not an IP",
+			"for-sure-not-an-IP",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			IPv4().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestIPv6(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"2001:0db8:85a3:08d3::0370:7344",
+			true,
+		},
+		{
+			"ok short",
+			"::1",
+			true,
+		},
+		{
+			"IP4",
+			"111.222.111.222",
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+		{
+			"Not an IP",
+			"for-sure-not-an-IP",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			IPv6().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestHostname(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"www.example.com",
+			true,
+		},
+		{
+			"ok trailing dot",
+			"www.example.com.",
+			true,
+		},
+		{
+			"wildcard",
+			"*.example.com",
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+		{
+			"invalid character",
+			"www.exa mple.com",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			Hostname().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestEmail(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"hostmaster@example.com",
+			true,
+		},
+		{
+			"ok dot in local part",
+			"dns.admin@example.com",
+			true,
+		},
+		{
+			"missing domain",
+			"hostmaster",
+			false,
+		},
+		{
+			"display name",
+			"Hostmaster <hostmaster@example.com>",
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			Email().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestMXRecord(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"10 mail.example.com.",
+			true,
+		},
+		{
+			"ok zero preference",
+			"0 mail.example.com",
+			true,
+		},
+		{
+			"missing preference",
+			"mail.example.com.",
+			false,
+		},
+		{
+			"preference too big",
+			"65536 mail.example.com.",
+			false,
+		},
+		{
+			"invalid exchange",
+			"10 mail..example.com",
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			MXRecord().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestSRVRecord(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"10 5 5060 sip.example.com.",
+			true,
+		},
+		{
+			"ok no service",
+			"0 0 0 .",
+			true,
+		},
+		{
+			"missing port",
+			"10 5 sip.example.com.",
+			false,
+		},
+		{
+			"port too big",
+			"10 5 70000 sip.example.com.",
+			false,
+		},
+		{
+			"invalid target",
+			"10 5 5060 -sip.example.com",
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			SRVRecord().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestCAARecord(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			`0 issue "letsencrypt.org"`,
+			true,
+		},
+		{
+			"ok iodef",
+			`128 iodef "mailto:security@example.com"`,
+			true,
+		},
+		{
+			"flags too big",
+			`256 issue "letsencrypt.org"`,
+			false,
+		},
+		{
+			"missing tag",
+			`0 "letsencrypt.org"`,
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			CAARecord().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestRecordSetName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"www.example.com",
+			true,
+		},
+		{
+			"ok trailing dot",
+			"www.example.com.",
+			true,
+		},
+		{
+			"ok single label",
+			"example",
+			true,
+		},
+		{
+			"ok underscore",
+			"_sip._tcp.example.com.",
+			true,
+		},
+		{
+			"ok wildcard",
+			"*.example.com.",
+			true,
+		},
+		{
+			"ok long name",
+			strings.Repeat(strings.Repeat("a", 63)+".", 3) + strings.Repeat("a", 61),
+			true,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+		{
+			"only dot",
+			".",
+			false,
+		},
+		{
+			"too long",
+			strings.Repeat(strings.Repeat("a", 63)+".", 3) + strings.Repeat("a", 62),
+			false,
+		},
+		{
+			"label too long",
+			strings.Repeat("a", 64) + ".example.com",
+			false,
+		},
+		{
+			"empty label",
+			"www..example.com",
+			false,
+		},
+		{
+			"wildcard not leftmost",
+			"www.*.example.com",
+			false,
+		},
+		{
+			"partial wildcard",
+			"w*.example.com",
+			false,
+		},
+		{
+			"leading hyphen",
+			"-www.example.com",
+			false,
+		},
+		{
+			"trailing hyphen",
+			"www-.example.com",
+			false,
+		},
+		{
+			"invalid character",
+			"www.exa mple.com",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			RecordSetName().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestCIDRList(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"single IPv4 network",
+			"192.168.0.0/24",
+			true,
+		},
+		{
+			"IPv4 and IPv6 networks",
+			"0.0.0.0/0,::/0",
+			true,
+		},
+		{
+			"whitespace",
+			"192.168.0.0/24, 2001:db8::/32",
+			true,
+		},
+		{
+			"IP without prefix length",
+			"192.168.0.1",
+			false,
+		},
+		{
+			"empty entry",
+			"192.168.0.0/24,",
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+		{
+			"invalid prefix length",
+			"192.168.0.0/33",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			CIDRList().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestSgwACL(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"single network",
+			"192.168.0.0/16",
+			true,
+		},
+		{
+			"multiple networks",
+			"1.2.3.4/31, 10.0.0.0/8,2001:db8::/32",
+			true,
+		},
+		{
+			"host address",
+			"1.2.3.4/32",
+			true,
+		},
+		{
+			"host bits set",
+			"1.2.3.4/4",
+			false,
+		},
+		{
+			"host bits set in second entry",
+			"10.0.0.0/8,192.168.0.1/24",
+			false,
+		},
+		{
+			"IPv6 host bits set",
+			"2001:db8::1/32",
+			false,
+		},
+		{
+			"IP without prefix length",
+			"192.168.0.1",
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			SgwACL().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestDataSourceUUID(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"cae27bba-c43d-498a-861e-d11d241c4ff8",
+			true,
+		},
+		{
+			"upper case",
+			"CAE27BBA-C43D-498A-861E-D11D241C4FF8",
+			true,
+		},
+		{
+			"URN",
+			"urn:stackit:project:cae27bba-c43d-498a-861e-d11d241c4ff8",
+			true,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+		{
+			"not UUID",
+			"a-b-c-d",
+			false,
+		},
+		{
+			"URN without UUID",
+			"urn:stackit:project:name",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			DataSourceUUID().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
 func TestNoSeparator(t *testing.T) {
 	tests := []struct {
 		description string
@@ -208,3 +841,179 @@ func TestSemanticMinorVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"minutes",
+			"30m",
+			true,
+		},
+		{
+			"hours_and_minutes",
+			"1h30m",
+			true,
+		},
+		{
+			"empty",
+			"",
+			false,
+		},
+		{
+			"no_unit",
+			"30",
+			false,
+		},
+		{
+			"zero",
+			"0s",
+			false,
+		},
+		{
+			"negative",
+			"-5m",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			Duration().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestDNSRecords(t *testing.T) {
+	tests := []struct {
+		description string
+		recordType  types.String
+		records     []string
+		isValid     bool
+	}{
+		{
+			description: "a_ok",
+			recordType:  types.StringValue("A"),
+			records:     []string{"1.2.3.4", "5.6.7.8"},
+			isValid:     true,
+		},
+		{
+			description: "a_ipv6_fail",
+			recordType:  types.StringValue("A"),
+			records:     []string{"1.2.3.4", "2001:db8::1"},
+			isValid:     false,
+		},
+		{
+			description: "aaaa_ok",
+			recordType:  types.StringValue("AAAA"),
+			records:     []string{"2001:db8::1"},
+			isValid:     true,
+		},
+		{
+			description: "aaaa_ipv4_fail",
+			recordType:  types.StringValue("AAAA"),
+			records:     []string{"1.2.3.4"},
+			isValid:     false,
+		},
+		{
+			description: "cname_ok",
+			recordType:  types.StringValue("CNAME"),
+			records:     []string{"www.example.com."},
+			isValid:     true,
+		},
+		{
+			description: "cname_ip_fail",
+			recordType:  types.StringValue("CNAME"),
+			records:     []string{"www example com"},
+			isValid:     false,
+		},
+		{
+			description: "ns_lowercase_type_ok",
+			recordType:  types.StringValue("ns"),
+			records:     []string{"ns1.example.com."},
+			isValid:     true,
+		},
+		{
+			description: "mx_ok",
+			recordType:  types.StringValue("MX"),
+			records:     []string{"10 mail.example.com."},
+			isValid:     true,
+		},
+		{
+			description: "mx_missing_preference_fail",
+			recordType:  types.StringValue("MX"),
+			records:     []string{"mail.example.com."},
+			isValid:     false,
+		},
+		{
+			description: "txt_ok",
+			recordType:  types.StringValue("TXT"),
+			records:     []string{"v=spf1 include:example.com ~all"},
+			isValid:     true,
+		},
+		{
+			description: "srv_ok",
+			recordType:  types.StringValue("SRV"),
+			records:     []string{"10 5 5060 sip.example.com."},
+			isValid:     true,
+		},
+		{
+			description: "srv_fail",
+			recordType:  types.StringValue("SRV"),
+			records:     []string{"10 5 sip.example.com."},
+			isValid:     false,
+		},
+		{
+			description: "caa_ok",
+			recordType:  types.StringValue("CAA"),
+			records:     []string{`0 issue "letsencrypt.org"`},
+			isValid:     true,
+		},
+		{
+			description: "caa_fail",
+			recordType:  types.StringValue("CAA"),
+			records:     []string{"letsencrypt.org"},
+			isValid:     false,
+		},
+		{
+			description: "null_type_skipped",
+			recordType:  types.StringNull(),
+			records:     []string{"anything"},
+			isValid:     true,
+		},
+		{
+			description: "unknown_type_skipped",
+			recordType:  types.StringUnknown(),
+			records:     []string{"anything"},
+			isValid:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			records := []attr.Value{}
+			for _, r := range tt.records {
+				records = append(records, types.StringValue(r))
+			}
+			diags := DNSRecords(context.Background(), tt.recordType, types.SetValueMust(types.StringType, records), path.Root("records"))
+
+			if tt.isValid && diags.HasError() {
+				t.Errorf("DNSRecords failed on valid input: %v", core.DiagsToError(diags))
+			}
+			if !tt.isValid && !diags.HasError() {
+				t.Errorf("DNSRecords didn't fail on invalid input")
+			}
+		})
+	}
+}